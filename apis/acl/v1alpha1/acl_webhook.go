@@ -0,0 +1,72 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/hujson"
+)
+
+// AnnotationKeyPolicyFormat is the annotation declaring the format of an
+// ACL's policy. The policy is Terraform's acl argument, so the format cannot
+// be a field of spec.forProvider without being sent to Terraform.
+const AnnotationKeyPolicyFormat = "tailscale.crossplane.io/policy-format"
+
+// Formats an ACL's policy may be declared in.
+const (
+	// PolicyFormatHuJSON is JSON with comments and trailing commas. Policies
+	// are HuJSON unless declared otherwise.
+	PolicyFormatHuJSON = "hujson"
+
+	// PolicyFormatJSON is strict JSON.
+	PolicyFormatJSON = "json"
+)
+
+const errFmtPolicyFormat = "annotation %s: unknown policy format %q, must be %q or %q"
+
+var _ admission.Validator = &ACL{}
+
+// ValidateCreate rejects an ACL whose policy is not valid in its declared
+// format.
+func (mg *ACL) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validatePolicy()
+}
+
+// ValidateUpdate rejects an ACL whose policy is not valid in its declared
+// format.
+func (mg *ACL) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validatePolicy()
+}
+
+// ValidateDelete accepts the deletion of any ACL.
+func (mg *ACL) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *ACL) validatePolicy() error {
+	validate := hujson.Validate
+	switch f, ok := mg.GetAnnotations()[AnnotationKeyPolicyFormat]; {
+	case !ok, f == PolicyFormatHuJSON:
+	case f == PolicyFormatJSON:
+		validate = hujson.ValidateJSON
+	default:
+		return errors.Errorf(errFmtPolicyFormat, AnnotationKeyPolicyFormat, f, PolicyFormatHuJSON, PolicyFormatJSON)
+	}
+
+	if mg.Spec.ForProvider.ACL != nil {
+		if err := validate([]byte(*mg.Spec.ForProvider.ACL)); err != nil {
+			return errors.Wrap(err, "spec.forProvider.acl")
+		}
+	}
+	if mg.Spec.InitProvider.ACL != nil {
+		if err := validate([]byte(*mg.Spec.InitProvider.ACL)); err != nil {
+			return errors.Wrap(err, "spec.initProvider.acl")
+		}
+	}
+	return nil
+}
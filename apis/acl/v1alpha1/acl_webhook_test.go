@@ -0,0 +1,67 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/supahlab/provider-tailscale/internal/hujson"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	commented := `{
+  // Allow everything.
+  "acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]
+}`
+
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		policy      string
+		want        error
+	}{
+		"HuJSONByDefault": {
+			reason: "A policy without a declared format should be accepted with comments.",
+			policy: commented,
+		},
+		"HuJSON": {
+			reason:      "A policy declared as HuJSON should be accepted with comments.",
+			annotations: map[string]string{AnnotationKeyPolicyFormat: PolicyFormatHuJSON},
+			policy:      commented,
+		},
+		"JSON": {
+			reason:      "A policy declared as JSON should be accepted without comments.",
+			annotations: map[string]string{AnnotationKeyPolicyFormat: PolicyFormatJSON},
+			policy:      `{"acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]}`,
+		},
+		"JSONWithComments": {
+			reason:      "A policy declared as JSON should be rejected with comments.",
+			annotations: map[string]string{AnnotationKeyPolicyFormat: PolicyFormatJSON},
+			policy:      commented,
+			want:        errors.Wrap(hujson.ValidateJSON([]byte(commented)), "spec.forProvider.acl"),
+		},
+		"UnknownFormat": {
+			reason:      "A policy declared in an unknown format should be rejected.",
+			annotations: map[string]string{AnnotationKeyPolicyFormat: "yaml"},
+			policy:      commented,
+			want:        errors.Errorf(errFmtPolicyFormat, AnnotationKeyPolicyFormat, "yaml", PolicyFormatHuJSON, PolicyFormatJSON),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &ACL{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			mg.Spec.ForProvider.ACL = &tc.policy
+			_, err := mg.ValidateCreate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
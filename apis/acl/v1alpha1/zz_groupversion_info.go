@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+// +kubebuilder:object:generate=true
+// +groupName=acl.tailscale.com
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	CRDGroup   = "acl.tailscale.com"
+	CRDVersion = "v1alpha1"
+)
+
+var (
+	// CRDGroupVersion is the API Group Version used to register the objects
+	CRDGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: CRDVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: CRDGroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
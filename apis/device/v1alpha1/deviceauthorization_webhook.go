@@ -0,0 +1,36 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &DeviceAuthorization{}
+
+// ValidateCreate rejects a DeviceAuthorization with a malformed device ID.
+func (mg *DeviceAuthorization) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a DeviceAuthorization with a malformed device ID.
+func (mg *DeviceAuthorization) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any DeviceAuthorization.
+func (mg *DeviceAuthorization) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *DeviceAuthorization) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.DeviceID(spec.Child("forProvider", "deviceId"), mg.Spec.ForProvider.DeviceID)
+	return append(errs, validation.DeviceID(spec.Child("initProvider", "deviceId"), mg.Spec.InitProvider.DeviceID)...)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &DeviceSubnetRoutes{}
+
+// ValidateCreate rejects a DeviceSubnetRoutes with a malformed device ID or
+// routes that are not CIDRs.
+func (mg *DeviceSubnetRoutes) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a DeviceSubnetRoutes with a malformed device ID or
+// routes that are not CIDRs.
+func (mg *DeviceSubnetRoutes) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any DeviceSubnetRoutes.
+func (mg *DeviceSubnetRoutes) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *DeviceSubnetRoutes) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.DeviceID(spec.Child("forProvider", "deviceId"), mg.Spec.ForProvider.DeviceID)
+	errs = append(errs, validation.DeviceID(spec.Child("initProvider", "deviceId"), mg.Spec.InitProvider.DeviceID)...)
+	errs = append(errs, validation.CIDRs(spec.Child("forProvider", "routes"), mg.Spec.ForProvider.Routes)...)
+	return append(errs, validation.CIDRs(spec.Child("initProvider", "routes"), mg.Spec.InitProvider.Routes)...)
+}
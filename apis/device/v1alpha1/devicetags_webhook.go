@@ -0,0 +1,40 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &DeviceTags{}
+
+// ValidateCreate rejects a DeviceTags with a malformed device ID or tags that
+// lack the tag: prefix.
+func (mg *DeviceTags) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a DeviceTags with a malformed device ID or tags that
+// lack the tag: prefix.
+func (mg *DeviceTags) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any DeviceTags.
+func (mg *DeviceTags) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *DeviceTags) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.DeviceID(spec.Child("forProvider", "deviceId"), mg.Spec.ForProvider.DeviceID)
+	errs = append(errs, validation.DeviceID(spec.Child("initProvider", "deviceId"), mg.Spec.InitProvider.DeviceID)...)
+	errs = append(errs, validation.Tags(spec.Child("forProvider", "tags"), mg.Spec.ForProvider.Tags)...)
+	return append(errs, validation.Tags(spec.Child("initProvider", "tags"), mg.Spec.InitProvider.Tags)...)
+}
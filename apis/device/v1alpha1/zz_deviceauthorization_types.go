@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DeviceAuthorizationInitParameters struct {
+
+	// (Boolean) Whether or not the device is authorized
+	// Whether or not the device is authorized
+	Authorized *bool `json:"authorized,omitempty" tf:"authorized,omitempty"`
+
+	// (String) The device to set as authorized
+	// The device to set as authorized
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+}
+
+type DeviceAuthorizationObservation struct {
+
+	// (Boolean) Whether or not the device is authorized
+	// Whether or not the device is authorized
+	Authorized *bool `json:"authorized,omitempty" tf:"authorized,omitempty"`
+
+	// (String) The device to set as authorized
+	// The device to set as authorized
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+}
+
+type DeviceAuthorizationParameters struct {
+
+	// (Boolean) Whether or not the device is authorized
+	// Whether or not the device is authorized
+	// +kubebuilder:validation:Optional
+	Authorized *bool `json:"authorized,omitempty" tf:"authorized,omitempty"`
+
+	// (String) The device to set as authorized
+	// The device to set as authorized
+	// +kubebuilder:validation:Optional
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+}
+
+// DeviceAuthorizationSpec defines the desired state of DeviceAuthorization
+type DeviceAuthorizationSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DeviceAuthorizationParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DeviceAuthorizationInitParameters `json:"initProvider,omitempty"`
+}
+
+// DeviceAuthorizationStatus defines the observed state of DeviceAuthorization.
+type DeviceAuthorizationStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DeviceAuthorizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DeviceAuthorization is the Schema for the DeviceAuthorizations API. The device_authorization resource is used to approve new devices before they can join the tailnet. See https://tailscale.com/kb/1099/device-authorization/ for more details.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DeviceAuthorization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.authorized) || (has(self.initProvider) && has(self.initProvider.authorized))",message="spec.forProvider.authorized is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.deviceId) || (has(self.initProvider) && has(self.initProvider.deviceId))",message="spec.forProvider.deviceId is a required parameter"
+	Spec   DeviceAuthorizationSpec   `json:"spec"`
+	Status DeviceAuthorizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceAuthorizationList contains a list of DeviceAuthorizations
+type DeviceAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceAuthorization `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DeviceAuthorization_Kind             = "DeviceAuthorization"
+	DeviceAuthorization_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DeviceAuthorization_Kind}.String()
+	DeviceAuthorization_KindAPIVersion   = DeviceAuthorization_Kind + "." + CRDGroupVersion.String()
+	DeviceAuthorization_GroupVersionKind = CRDGroupVersion.WithKind(DeviceAuthorization_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DeviceAuthorization{}, &DeviceAuthorizationList{})
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DeviceKeyInitParameters struct {
+
+	// (String) The device to update the key properties of
+	// The device to update the key properties of
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (Boolean) Determines whether or not the device's key will expire. Defaults to `false`.
+	// Determines whether or not the device's key will expire. Defaults to `false`.
+	KeyExpiryDisabled *bool `json:"keyExpiryDisabled,omitempty" tf:"key_expiry_disabled,omitempty"`
+}
+
+type DeviceKeyObservation struct {
+
+	// (String) The device to update the key properties of
+	// The device to update the key properties of
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Boolean) Determines whether or not the device's key will expire. Defaults to `false`.
+	// Determines whether or not the device's key will expire. Defaults to `false`.
+	KeyExpiryDisabled *bool `json:"keyExpiryDisabled,omitempty" tf:"key_expiry_disabled,omitempty"`
+}
+
+type DeviceKeyParameters struct {
+
+	// (String) The device to update the key properties of
+	// The device to update the key properties of
+	// +kubebuilder:validation:Optional
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (Boolean) Determines whether or not the device's key will expire. Defaults to `false`.
+	// Determines whether or not the device's key will expire. Defaults to `false`.
+	// +kubebuilder:validation:Optional
+	KeyExpiryDisabled *bool `json:"keyExpiryDisabled,omitempty" tf:"key_expiry_disabled,omitempty"`
+}
+
+// DeviceKeySpec defines the desired state of DeviceKey
+type DeviceKeySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DeviceKeyParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DeviceKeyInitParameters `json:"initProvider,omitempty"`
+}
+
+// DeviceKeyStatus defines the observed state of DeviceKey.
+type DeviceKeyStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DeviceKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DeviceKey is the Schema for the DeviceKeys API. The device_key resource allows you to update the properties of a device's key
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DeviceKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.deviceId) || (has(self.initProvider) && has(self.initProvider.deviceId))",message="spec.forProvider.deviceId is a required parameter"
+	Spec   DeviceKeySpec   `json:"spec"`
+	Status DeviceKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceKeyList contains a list of DeviceKeys
+type DeviceKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceKey `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DeviceKey_Kind             = "DeviceKey"
+	DeviceKey_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DeviceKey_Kind}.String()
+	DeviceKey_KindAPIVersion   = DeviceKey_Kind + "." + CRDGroupVersion.String()
+	DeviceKey_GroupVersionKind = CRDGroupVersion.WithKind(DeviceKey_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DeviceKey{}, &DeviceKeyList{})
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DeviceSubnetRoutesInitParameters struct {
+
+	// (String) The device to set subnet routes for
+	// The device to set subnet routes for
+	// +crossplane:generate:reference:type=github.com/supahlab/provider-tailscale/apis/device/v1alpha1.DeviceAuthorization
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("device_id", false)
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// Reference to a DeviceAuthorization in device to populate deviceId.
+	// +kubebuilder:validation:Optional
+	DeviceIDRef *v1.Reference `json:"deviceIdRef,omitempty" tf:"-"`
+
+	// Selector for a DeviceAuthorization in device to populate deviceId.
+	// +kubebuilder:validation:Optional
+	DeviceIDSelector *v1.Selector `json:"deviceIdSelector,omitempty" tf:"-"`
+
+	// (Set of String) The subnet routes that are enabled to be routed by a device
+	// The subnet routes that are enabled to be routed by a device
+	// +listType=set
+	Routes []*string `json:"routes,omitempty" tf:"routes,omitempty"`
+}
+
+type DeviceSubnetRoutesObservation struct {
+
+	// (String) The device to set subnet routes for
+	// The device to set subnet routes for
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Set of String) The subnet routes that are enabled to be routed by a device
+	// The subnet routes that are enabled to be routed by a device
+	// +listType=set
+	Routes []*string `json:"routes,omitempty" tf:"routes,omitempty"`
+}
+
+type DeviceSubnetRoutesParameters struct {
+
+	// (String) The device to set subnet routes for
+	// The device to set subnet routes for
+	// +crossplane:generate:reference:type=github.com/supahlab/provider-tailscale/apis/device/v1alpha1.DeviceAuthorization
+	// +crossplane:generate:reference:extractor=github.com/crossplane/upjet/pkg/resource.ExtractParamPath("device_id", false)
+	// +kubebuilder:validation:Optional
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// Reference to a DeviceAuthorization in device to populate deviceId.
+	// +kubebuilder:validation:Optional
+	DeviceIDRef *v1.Reference `json:"deviceIdRef,omitempty" tf:"-"`
+
+	// Selector for a DeviceAuthorization in device to populate deviceId.
+	// +kubebuilder:validation:Optional
+	DeviceIDSelector *v1.Selector `json:"deviceIdSelector,omitempty" tf:"-"`
+
+	// (Set of String) The subnet routes that are enabled to be routed by a device
+	// The subnet routes that are enabled to be routed by a device
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Routes []*string `json:"routes,omitempty" tf:"routes,omitempty"`
+}
+
+// DeviceSubnetRoutesSpec defines the desired state of DeviceSubnetRoutes
+type DeviceSubnetRoutesSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DeviceSubnetRoutesParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DeviceSubnetRoutesInitParameters `json:"initProvider,omitempty"`
+}
+
+// DeviceSubnetRoutesStatus defines the observed state of DeviceSubnetRoutes.
+type DeviceSubnetRoutesStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DeviceSubnetRoutesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DeviceSubnetRoutes is the Schema for the DeviceSubnetRoutess API. The device_subnet_routes resource allows you to configure enabled subnet routes for your Tailscale devices. See https://tailscale.com/kb/1019/subnets for more information.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DeviceSubnetRoutes struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.routes) || (has(self.initProvider) && has(self.initProvider.routes))",message="spec.forProvider.routes is a required parameter"
+	Spec   DeviceSubnetRoutesSpec   `json:"spec"`
+	Status DeviceSubnetRoutesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceSubnetRoutesList contains a list of DeviceSubnetRoutess
+type DeviceSubnetRoutesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceSubnetRoutes `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DeviceSubnetRoutes_Kind             = "DeviceSubnetRoutes"
+	DeviceSubnetRoutes_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DeviceSubnetRoutes_Kind}.String()
+	DeviceSubnetRoutes_KindAPIVersion   = DeviceSubnetRoutes_Kind + "." + CRDGroupVersion.String()
+	DeviceSubnetRoutes_GroupVersionKind = CRDGroupVersion.WithKind(DeviceSubnetRoutes_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DeviceSubnetRoutes{}, &DeviceSubnetRoutesList{})
+}
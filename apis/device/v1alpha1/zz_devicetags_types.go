@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DeviceTagsInitParameters struct {
+
+	// (String) The device to set tags for
+	// The device to set tags for
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (Set of String) The tags to apply to the device
+	// The tags to apply to the device
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+type DeviceTagsObservation struct {
+
+	// (String) The device to set tags for
+	// The device to set tags for
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Set of String) The tags to apply to the device
+	// The tags to apply to the device
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+type DeviceTagsParameters struct {
+
+	// (String) The device to set tags for
+	// The device to set tags for
+	// +kubebuilder:validation:Optional
+	DeviceID *string `json:"deviceId,omitempty" tf:"device_id,omitempty"`
+
+	// (Set of String) The tags to apply to the device
+	// The tags to apply to the device
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+// DeviceTagsSpec defines the desired state of DeviceTags
+type DeviceTagsSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DeviceTagsParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DeviceTagsInitParameters `json:"initProvider,omitempty"`
+}
+
+// DeviceTagsStatus defines the observed state of DeviceTags.
+type DeviceTagsStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DeviceTagsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DeviceTags is the Schema for the DeviceTagss API. The device_tags resource is used to apply tags to Tailscale devices. See https://tailscale.com/kb/1068/acl-tags/ for more details.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DeviceTags struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.deviceId) || (has(self.initProvider) && has(self.initProvider.deviceId))",message="spec.forProvider.deviceId is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.tags) || (has(self.initProvider) && has(self.initProvider.tags))",message="spec.forProvider.tags is a required parameter"
+	Spec   DeviceTagsSpec   `json:"spec"`
+	Status DeviceTagsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceTagsList contains a list of DeviceTagss
+type DeviceTagsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceTags `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DeviceTags_Kind             = "DeviceTags"
+	DeviceTags_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DeviceTags_Kind}.String()
+	DeviceTags_KindAPIVersion   = DeviceTags_Kind + "." + CRDGroupVersion.String()
+	DeviceTags_GroupVersionKind = CRDGroupVersion.WithKind(DeviceTags_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DeviceTags{}, &DeviceTagsList{})
+}
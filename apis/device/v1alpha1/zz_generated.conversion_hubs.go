@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+// Hub marks this type as a conversion hub.
+func (tr *DeviceAuthorization) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *DeviceKey) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *DeviceSubnetRoutes) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (tr *DeviceTags) Hub() {}
@@ -0,0 +1,766 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorization) DeepCopyInto(out *DeviceAuthorization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorization.
+func (in *DeviceAuthorization) DeepCopy() *DeviceAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceAuthorization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationInitParameters) DeepCopyInto(out *DeviceAuthorizationInitParameters) {
+	*out = *in
+	if in.Authorized != nil {
+		in, out := &in.Authorized, &out.Authorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationInitParameters.
+func (in *DeviceAuthorizationInitParameters) DeepCopy() *DeviceAuthorizationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationList) DeepCopyInto(out *DeviceAuthorizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceAuthorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationList.
+func (in *DeviceAuthorizationList) DeepCopy() *DeviceAuthorizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceAuthorizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationObservation) DeepCopyInto(out *DeviceAuthorizationObservation) {
+	*out = *in
+	if in.Authorized != nil {
+		in, out := &in.Authorized, &out.Authorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationObservation.
+func (in *DeviceAuthorizationObservation) DeepCopy() *DeviceAuthorizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationParameters) DeepCopyInto(out *DeviceAuthorizationParameters) {
+	*out = *in
+	if in.Authorized != nil {
+		in, out := &in.Authorized, &out.Authorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationParameters.
+func (in *DeviceAuthorizationParameters) DeepCopy() *DeviceAuthorizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationSpec) DeepCopyInto(out *DeviceAuthorizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationSpec.
+func (in *DeviceAuthorizationSpec) DeepCopy() *DeviceAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAuthorizationStatus) DeepCopyInto(out *DeviceAuthorizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAuthorizationStatus.
+func (in *DeviceAuthorizationStatus) DeepCopy() *DeviceAuthorizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAuthorizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKey) DeepCopyInto(out *DeviceKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKey.
+func (in *DeviceKey) DeepCopy() *DeviceKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeyInitParameters) DeepCopyInto(out *DeviceKeyInitParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyExpiryDisabled != nil {
+		in, out := &in.KeyExpiryDisabled, &out.KeyExpiryDisabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeyInitParameters.
+func (in *DeviceKeyInitParameters) DeepCopy() *DeviceKeyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeyList) DeepCopyInto(out *DeviceKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeyList.
+func (in *DeviceKeyList) DeepCopy() *DeviceKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeyObservation) DeepCopyInto(out *DeviceKeyObservation) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyExpiryDisabled != nil {
+		in, out := &in.KeyExpiryDisabled, &out.KeyExpiryDisabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeyObservation.
+func (in *DeviceKeyObservation) DeepCopy() *DeviceKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeyParameters) DeepCopyInto(out *DeviceKeyParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyExpiryDisabled != nil {
+		in, out := &in.KeyExpiryDisabled, &out.KeyExpiryDisabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeyParameters.
+func (in *DeviceKeyParameters) DeepCopy() *DeviceKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeySpec) DeepCopyInto(out *DeviceKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeySpec.
+func (in *DeviceKeySpec) DeepCopy() *DeviceKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceKeyStatus) DeepCopyInto(out *DeviceKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceKeyStatus.
+func (in *DeviceKeyStatus) DeepCopy() *DeviceKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutes) DeepCopyInto(out *DeviceSubnetRoutes) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutes.
+func (in *DeviceSubnetRoutes) DeepCopy() *DeviceSubnetRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceSubnetRoutes) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesInitParameters) DeepCopyInto(out *DeviceSubnetRoutesInitParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceIDRef != nil {
+		in, out := &in.DeviceIDRef, &out.DeviceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceIDSelector != nil {
+		in, out := &in.DeviceIDSelector, &out.DeviceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesInitParameters.
+func (in *DeviceSubnetRoutesInitParameters) DeepCopy() *DeviceSubnetRoutesInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesList) DeepCopyInto(out *DeviceSubnetRoutesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceSubnetRoutes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesList.
+func (in *DeviceSubnetRoutesList) DeepCopy() *DeviceSubnetRoutesList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceSubnetRoutesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesObservation) DeepCopyInto(out *DeviceSubnetRoutesObservation) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesObservation.
+func (in *DeviceSubnetRoutesObservation) DeepCopy() *DeviceSubnetRoutesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesParameters) DeepCopyInto(out *DeviceSubnetRoutesParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceIDRef != nil {
+		in, out := &in.DeviceIDRef, &out.DeviceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceIDSelector != nil {
+		in, out := &in.DeviceIDSelector, &out.DeviceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesParameters.
+func (in *DeviceSubnetRoutesParameters) DeepCopy() *DeviceSubnetRoutesParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesSpec) DeepCopyInto(out *DeviceSubnetRoutesSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesSpec.
+func (in *DeviceSubnetRoutesSpec) DeepCopy() *DeviceSubnetRoutesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceSubnetRoutesStatus) DeepCopyInto(out *DeviceSubnetRoutesStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSubnetRoutesStatus.
+func (in *DeviceSubnetRoutesStatus) DeepCopy() *DeviceSubnetRoutesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceSubnetRoutesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTags) DeepCopyInto(out *DeviceTags) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTags.
+func (in *DeviceTags) DeepCopy() *DeviceTags {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceTags) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsInitParameters) DeepCopyInto(out *DeviceTagsInitParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsInitParameters.
+func (in *DeviceTagsInitParameters) DeepCopy() *DeviceTagsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsList) DeepCopyInto(out *DeviceTagsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceTags, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsList.
+func (in *DeviceTagsList) DeepCopy() *DeviceTagsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceTagsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsObservation) DeepCopyInto(out *DeviceTagsObservation) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsObservation.
+func (in *DeviceTagsObservation) DeepCopy() *DeviceTagsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsParameters) DeepCopyInto(out *DeviceTagsParameters) {
+	*out = *in
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsParameters.
+func (in *DeviceTagsParameters) DeepCopy() *DeviceTagsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsSpec) DeepCopyInto(out *DeviceTagsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsSpec.
+func (in *DeviceTagsSpec) DeepCopy() *DeviceTagsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceTagsStatus) DeepCopyInto(out *DeviceTagsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceTagsStatus.
+func (in *DeviceTagsStatus) DeepCopy() *DeviceTagsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceTagsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
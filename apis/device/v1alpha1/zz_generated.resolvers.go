@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	resource "github.com/crossplane/upjet/pkg/resource"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this DeviceSubnetRoutes.
+func (mg *DeviceSubnetRoutes) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DeviceID),
+		Extract:      resource.ExtractParamPath("device_id", false),
+		Reference:    mg.Spec.ForProvider.DeviceIDRef,
+		Selector:     mg.Spec.ForProvider.DeviceIDSelector,
+		To: reference.To{
+			List:    &DeviceAuthorizationList{},
+			Managed: &DeviceAuthorization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DeviceID")
+	}
+	mg.Spec.ForProvider.DeviceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DeviceIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.DeviceID),
+		Extract:      resource.ExtractParamPath("device_id", false),
+		Reference:    mg.Spec.InitProvider.DeviceIDRef,
+		Selector:     mg.Spec.InitProvider.DeviceIDSelector,
+		To: reference.To{
+			List:    &DeviceAuthorizationList{},
+			Managed: &DeviceAuthorization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.InitProvider.DeviceID")
+	}
+	mg.Spec.InitProvider.DeviceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.InitProvider.DeviceIDRef = rsp.ResolvedReference
+
+	return nil
+}
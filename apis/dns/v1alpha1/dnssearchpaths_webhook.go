@@ -0,0 +1,38 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &DNSSearchPaths{}
+
+// ValidateCreate rejects a DNSSearchPaths with search paths that are not DNS
+// suffixes.
+func (mg *DNSSearchPaths) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a DNSSearchPaths with search paths that are not DNS
+// suffixes.
+func (mg *DNSSearchPaths) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any DNSSearchPaths.
+func (mg *DNSSearchPaths) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *DNSSearchPaths) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.DNSSuffixes(spec.Child("forProvider", "searchPaths"), mg.Spec.ForProvider.SearchPaths)
+	return append(errs, validation.DNSSuffixes(spec.Child("initProvider", "searchPaths"), mg.Spec.InitProvider.SearchPaths)...)
+}
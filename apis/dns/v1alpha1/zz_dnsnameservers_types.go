@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DNSNameserversInitParameters struct {
+
+	// (List of String) Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	// Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	Nameservers []*string `json:"nameservers,omitempty" tf:"nameservers,omitempty"`
+}
+
+type DNSNameserversObservation struct {
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (List of String) Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	// Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	Nameservers []*string `json:"nameservers,omitempty" tf:"nameservers,omitempty"`
+}
+
+type DNSNameserversParameters struct {
+
+	// (List of String) Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	// Devices on your network will use these nameservers to resolve DNS names. IPv4 or IPv6 addresses are accepted.
+	// +kubebuilder:validation:Optional
+	Nameservers []*string `json:"nameservers,omitempty" tf:"nameservers,omitempty"`
+}
+
+// DNSNameserversSpec defines the desired state of DNSNameservers
+type DNSNameserversSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DNSNameserversParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DNSNameserversInitParameters `json:"initProvider,omitempty"`
+}
+
+// DNSNameserversStatus defines the observed state of DNSNameservers.
+type DNSNameserversStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DNSNameserversObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DNSNameservers is the Schema for the DNSNameserverss API. The dns_nameservers resource allows you to configure DNS nameservers for your Tailscale network. See https://tailscale.com/kb/1054/dns for more information.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DNSNameservers struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.nameservers) || (has(self.initProvider) && has(self.initProvider.nameservers))",message="spec.forProvider.nameservers is a required parameter"
+	Spec   DNSNameserversSpec   `json:"spec"`
+	Status DNSNameserversStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSNameserversList contains a list of DNSNameserverss
+type DNSNameserversList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSNameservers `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DNSNameservers_Kind             = "DNSNameservers"
+	DNSNameservers_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DNSNameservers_Kind}.String()
+	DNSNameservers_KindAPIVersion   = DNSNameservers_Kind + "." + CRDGroupVersion.String()
+	DNSNameservers_GroupVersionKind = CRDGroupVersion.WithKind(DNSNameservers_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DNSNameservers{}, &DNSNameserversList{})
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DNSPreferencesInitParameters struct {
+
+	// (Boolean) Whether or not to enable magic DNS
+	// Whether or not to enable magic DNS
+	MagicDNS *bool `json:"magicDns,omitempty" tf:"magic_dns,omitempty"`
+}
+
+type DNSPreferencesObservation struct {
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Boolean) Whether or not to enable magic DNS
+	// Whether or not to enable magic DNS
+	MagicDNS *bool `json:"magicDns,omitempty" tf:"magic_dns,omitempty"`
+}
+
+type DNSPreferencesParameters struct {
+
+	// (Boolean) Whether or not to enable magic DNS
+	// Whether or not to enable magic DNS
+	// +kubebuilder:validation:Optional
+	MagicDNS *bool `json:"magicDns,omitempty" tf:"magic_dns,omitempty"`
+}
+
+// DNSPreferencesSpec defines the desired state of DNSPreferences
+type DNSPreferencesSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DNSPreferencesParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DNSPreferencesInitParameters `json:"initProvider,omitempty"`
+}
+
+// DNSPreferencesStatus defines the observed state of DNSPreferences.
+type DNSPreferencesStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DNSPreferencesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DNSPreferences is the Schema for the DNSPreferencess API. The dns_preferences resource allows you to configure DNS preferences for your Tailscale network. See https://tailscale.com/kb/1054/dns for more information.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DNSPreferences struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.magicDns) || (has(self.initProvider) && has(self.initProvider.magicDns))",message="spec.forProvider.magicDns is a required parameter"
+	Spec   DNSPreferencesSpec   `json:"spec"`
+	Status DNSPreferencesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSPreferencesList contains a list of DNSPreferencess
+type DNSPreferencesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSPreferences `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DNSPreferences_Kind             = "DNSPreferences"
+	DNSPreferences_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DNSPreferences_Kind}.String()
+	DNSPreferences_KindAPIVersion   = DNSPreferences_Kind + "." + CRDGroupVersion.String()
+	DNSPreferences_GroupVersionKind = CRDGroupVersion.WithKind(DNSPreferences_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DNSPreferences{}, &DNSPreferencesList{})
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type DNSSearchPathsInitParameters struct {
+
+	// (List of String) Devices on your network will use these domain suffixes to resolve DNS names.
+	// Devices on your network will use these domain suffixes to resolve DNS names.
+	SearchPaths []*string `json:"searchPaths,omitempty" tf:"search_paths,omitempty"`
+}
+
+type DNSSearchPathsObservation struct {
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (List of String) Devices on your network will use these domain suffixes to resolve DNS names.
+	// Devices on your network will use these domain suffixes to resolve DNS names.
+	SearchPaths []*string `json:"searchPaths,omitempty" tf:"search_paths,omitempty"`
+}
+
+type DNSSearchPathsParameters struct {
+
+	// (List of String) Devices on your network will use these domain suffixes to resolve DNS names.
+	// Devices on your network will use these domain suffixes to resolve DNS names.
+	// +kubebuilder:validation:Optional
+	SearchPaths []*string `json:"searchPaths,omitempty" tf:"search_paths,omitempty"`
+}
+
+// DNSSearchPathsSpec defines the desired state of DNSSearchPaths
+type DNSSearchPathsSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     DNSSearchPathsParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider DNSSearchPathsInitParameters `json:"initProvider,omitempty"`
+}
+
+// DNSSearchPathsStatus defines the observed state of DNSSearchPaths.
+type DNSSearchPathsStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        DNSSearchPathsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// DNSSearchPaths is the Schema for the DNSSearchPathss API. The dns_search_paths resource allows you to configure DNS search paths for your Tailscale network. See https://tailscale.com/kb/1054/dns for more information.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type DNSSearchPaths struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.searchPaths) || (has(self.initProvider) && has(self.initProvider.searchPaths))",message="spec.forProvider.searchPaths is a required parameter"
+	Spec   DNSSearchPathsSpec   `json:"spec"`
+	Status DNSSearchPathsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSSearchPathsList contains a list of DNSSearchPathss
+type DNSSearchPathsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSSearchPaths `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DNSSearchPaths_Kind             = "DNSSearchPaths"
+	DNSSearchPaths_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DNSSearchPaths_Kind}.String()
+	DNSSearchPaths_KindAPIVersion   = DNSSearchPaths_Kind + "." + CRDGroupVersion.String()
+	DNSSearchPaths_GroupVersionKind = CRDGroupVersion.WithKind(DNSSearchPaths_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DNSSearchPaths{}, &DNSSearchPathsList{})
+}
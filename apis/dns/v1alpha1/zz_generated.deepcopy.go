@@ -0,0 +1,718 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameservers) DeepCopyInto(out *DNSNameservers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameservers.
+func (in *DNSNameservers) DeepCopy() *DNSNameservers {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameservers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSNameservers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversInitParameters) DeepCopyInto(out *DNSNameserversInitParameters) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversInitParameters.
+func (in *DNSNameserversInitParameters) DeepCopy() *DNSNameserversInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversList) DeepCopyInto(out *DNSNameserversList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSNameservers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversList.
+func (in *DNSNameserversList) DeepCopy() *DNSNameserversList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSNameserversList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversObservation) DeepCopyInto(out *DNSNameserversObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversObservation.
+func (in *DNSNameserversObservation) DeepCopy() *DNSNameserversObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversParameters) DeepCopyInto(out *DNSNameserversParameters) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversParameters.
+func (in *DNSNameserversParameters) DeepCopy() *DNSNameserversParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversSpec) DeepCopyInto(out *DNSNameserversSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversSpec.
+func (in *DNSNameserversSpec) DeepCopy() *DNSNameserversSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNameserversStatus) DeepCopyInto(out *DNSNameserversStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNameserversStatus.
+func (in *DNSNameserversStatus) DeepCopy() *DNSNameserversStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNameserversStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferences) DeepCopyInto(out *DNSPreferences) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferences.
+func (in *DNSPreferences) DeepCopy() *DNSPreferences {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSPreferences) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesInitParameters) DeepCopyInto(out *DNSPreferencesInitParameters) {
+	*out = *in
+	if in.MagicDNS != nil {
+		in, out := &in.MagicDNS, &out.MagicDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesInitParameters.
+func (in *DNSPreferencesInitParameters) DeepCopy() *DNSPreferencesInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesList) DeepCopyInto(out *DNSPreferencesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSPreferences, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesList.
+func (in *DNSPreferencesList) DeepCopy() *DNSPreferencesList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSPreferencesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesObservation) DeepCopyInto(out *DNSPreferencesObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MagicDNS != nil {
+		in, out := &in.MagicDNS, &out.MagicDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesObservation.
+func (in *DNSPreferencesObservation) DeepCopy() *DNSPreferencesObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesParameters) DeepCopyInto(out *DNSPreferencesParameters) {
+	*out = *in
+	if in.MagicDNS != nil {
+		in, out := &in.MagicDNS, &out.MagicDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesParameters.
+func (in *DNSPreferencesParameters) DeepCopy() *DNSPreferencesParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesSpec) DeepCopyInto(out *DNSPreferencesSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesSpec.
+func (in *DNSPreferencesSpec) DeepCopy() *DNSPreferencesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPreferencesStatus) DeepCopyInto(out *DNSPreferencesStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPreferencesStatus.
+func (in *DNSPreferencesStatus) DeepCopy() *DNSPreferencesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPreferencesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPaths) DeepCopyInto(out *DNSSearchPaths) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPaths.
+func (in *DNSSearchPaths) DeepCopy() *DNSSearchPaths {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPaths)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSearchPaths) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsInitParameters) DeepCopyInto(out *DNSSearchPathsInitParameters) {
+	*out = *in
+	if in.SearchPaths != nil {
+		in, out := &in.SearchPaths, &out.SearchPaths
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsInitParameters.
+func (in *DNSSearchPathsInitParameters) DeepCopy() *DNSSearchPathsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsList) DeepCopyInto(out *DNSSearchPathsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSSearchPaths, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsList.
+func (in *DNSSearchPathsList) DeepCopy() *DNSSearchPathsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSearchPathsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsObservation) DeepCopyInto(out *DNSSearchPathsObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchPaths != nil {
+		in, out := &in.SearchPaths, &out.SearchPaths
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsObservation.
+func (in *DNSSearchPathsObservation) DeepCopy() *DNSSearchPathsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsParameters) DeepCopyInto(out *DNSSearchPathsParameters) {
+	*out = *in
+	if in.SearchPaths != nil {
+		in, out := &in.SearchPaths, &out.SearchPaths
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsParameters.
+func (in *DNSSearchPathsParameters) DeepCopy() *DNSSearchPathsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsSpec) DeepCopyInto(out *DNSSearchPathsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsSpec.
+func (in *DNSSearchPathsSpec) DeepCopy() *DNSSearchPathsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSearchPathsStatus) DeepCopyInto(out *DNSSearchPathsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSearchPathsStatus.
+func (in *DNSSearchPathsStatus) DeepCopy() *DNSSearchPathsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSearchPathsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameservers) DeepCopyInto(out *DNSSplitNameservers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameservers.
+func (in *DNSSplitNameservers) DeepCopy() *DNSSplitNameservers {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameservers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSplitNameservers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversInitParameters) DeepCopyInto(out *DNSSplitNameserversInitParameters) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversInitParameters.
+func (in *DNSSplitNameserversInitParameters) DeepCopy() *DNSSplitNameserversInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversList) DeepCopyInto(out *DNSSplitNameserversList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSSplitNameservers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversList.
+func (in *DNSSplitNameserversList) DeepCopy() *DNSSplitNameserversList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSSplitNameserversList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversObservation) DeepCopyInto(out *DNSSplitNameserversObservation) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversObservation.
+func (in *DNSSplitNameserversObservation) DeepCopy() *DNSSplitNameserversObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversParameters) DeepCopyInto(out *DNSSplitNameserversParameters) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversParameters.
+func (in *DNSSplitNameserversParameters) DeepCopy() *DNSSplitNameserversParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversSpec) DeepCopyInto(out *DNSSplitNameserversSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversSpec.
+func (in *DNSSplitNameserversSpec) DeepCopy() *DNSSplitNameserversSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSplitNameserversStatus) DeepCopyInto(out *DNSSplitNameserversStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSplitNameserversStatus.
+func (in *DNSSplitNameserversStatus) DeepCopy() *DNSSplitNameserversStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSplitNameserversStatus)
+	in.DeepCopyInto(out)
+	return out
+}
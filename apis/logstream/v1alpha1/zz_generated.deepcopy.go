@@ -0,0 +1,527 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalID) DeepCopyInto(out *AWSExternalID) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalID.
+func (in *AWSExternalID) DeepCopy() *AWSExternalID {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSExternalID) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDInitParameters) DeepCopyInto(out *AWSExternalIDInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDInitParameters.
+func (in *AWSExternalIDInitParameters) DeepCopy() *AWSExternalIDInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDList) DeepCopyInto(out *AWSExternalIDList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSExternalID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDList.
+func (in *AWSExternalIDList) DeepCopy() *AWSExternalIDList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSExternalIDList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDObservation) DeepCopyInto(out *AWSExternalIDObservation) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TailscaleAwsAccountID != nil {
+		in, out := &in.TailscaleAwsAccountID, &out.TailscaleAwsAccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDObservation.
+func (in *AWSExternalIDObservation) DeepCopy() *AWSExternalIDObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDParameters) DeepCopyInto(out *AWSExternalIDParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDParameters.
+func (in *AWSExternalIDParameters) DeepCopy() *AWSExternalIDParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDSpec) DeepCopyInto(out *AWSExternalIDSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	out.InitProvider = in.InitProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDSpec.
+func (in *AWSExternalIDSpec) DeepCopy() *AWSExternalIDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExternalIDStatus) DeepCopyInto(out *AWSExternalIDStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExternalIDStatus.
+func (in *AWSExternalIDStatus) DeepCopy() *AWSExternalIDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExternalIDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfiguration) DeepCopyInto(out *LogstreamConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfiguration.
+func (in *LogstreamConfiguration) DeepCopy() *LogstreamConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogstreamConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationInitParameters) DeepCopyInto(out *LogstreamConfigurationInitParameters) {
+	*out = *in
+	if in.CompressionFormat != nil {
+		in, out := &in.CompressionFormat, &out.CompressionFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationType != nil {
+		in, out := &in.DestinationType, &out.DestinationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogType != nil {
+		in, out := &in.LogType, &out.LogType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AccessKeyID != nil {
+		in, out := &in.S3AccessKeyID, &out.S3AccessKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AuthenticationType != nil {
+		in, out := &in.S3AuthenticationType, &out.S3AuthenticationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3ExternalID != nil {
+		in, out := &in.S3ExternalID, &out.S3ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Region != nil {
+		in, out := &in.S3Region, &out.S3Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3RoleArn != nil {
+		in, out := &in.S3RoleArn, &out.S3RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3SecretAccessKeySecretRef != nil {
+		in, out := &in.S3SecretAccessKeySecretRef, &out.S3SecretAccessKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadPeriodMinutes != nil {
+		in, out := &in.UploadPeriodMinutes, &out.UploadPeriodMinutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationInitParameters.
+func (in *LogstreamConfigurationInitParameters) DeepCopy() *LogstreamConfigurationInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationList) DeepCopyInto(out *LogstreamConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogstreamConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationList.
+func (in *LogstreamConfigurationList) DeepCopy() *LogstreamConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogstreamConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationObservation) DeepCopyInto(out *LogstreamConfigurationObservation) {
+	*out = *in
+	if in.CompressionFormat != nil {
+		in, out := &in.CompressionFormat, &out.CompressionFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationType != nil {
+		in, out := &in.DestinationType, &out.DestinationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogType != nil {
+		in, out := &in.LogType, &out.LogType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AccessKeyID != nil {
+		in, out := &in.S3AccessKeyID, &out.S3AccessKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AuthenticationType != nil {
+		in, out := &in.S3AuthenticationType, &out.S3AuthenticationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3ExternalID != nil {
+		in, out := &in.S3ExternalID, &out.S3ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Region != nil {
+		in, out := &in.S3Region, &out.S3Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3RoleArn != nil {
+		in, out := &in.S3RoleArn, &out.S3RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadPeriodMinutes != nil {
+		in, out := &in.UploadPeriodMinutes, &out.UploadPeriodMinutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationObservation.
+func (in *LogstreamConfigurationObservation) DeepCopy() *LogstreamConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationParameters) DeepCopyInto(out *LogstreamConfigurationParameters) {
+	*out = *in
+	if in.CompressionFormat != nil {
+		in, out := &in.CompressionFormat, &out.CompressionFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationType != nil {
+		in, out := &in.DestinationType, &out.DestinationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogType != nil {
+		in, out := &in.LogType, &out.LogType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AccessKeyID != nil {
+		in, out := &in.S3AccessKeyID, &out.S3AccessKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3AuthenticationType != nil {
+		in, out := &in.S3AuthenticationType, &out.S3AuthenticationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3ExternalID != nil {
+		in, out := &in.S3ExternalID, &out.S3ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Region != nil {
+		in, out := &in.S3Region, &out.S3Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3RoleArn != nil {
+		in, out := &in.S3RoleArn, &out.S3RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3SecretAccessKeySecretRef != nil {
+		in, out := &in.S3SecretAccessKeySecretRef, &out.S3SecretAccessKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadPeriodMinutes != nil {
+		in, out := &in.UploadPeriodMinutes, &out.UploadPeriodMinutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationParameters.
+func (in *LogstreamConfigurationParameters) DeepCopy() *LogstreamConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationSpec) DeepCopyInto(out *LogstreamConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationSpec.
+func (in *LogstreamConfigurationSpec) DeepCopy() *LogstreamConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogstreamConfigurationStatus) DeepCopyInto(out *LogstreamConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogstreamConfigurationStatus.
+func (in *LogstreamConfigurationStatus) DeepCopy() *LogstreamConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogstreamConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &OAuthClient{}
+
+// ValidateCreate rejects a OAuthClient with tags that lack the tag: prefix.
+func (mg *OAuthClient) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a OAuthClient with tags that lack the tag: prefix.
+func (mg *OAuthClient) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any OAuthClient.
+func (mg *OAuthClient) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *OAuthClient) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.Tags(spec.Child("forProvider", "tags"), mg.Spec.ForProvider.Tags)
+	return append(errs, validation.Tags(spec.Child("initProvider", "tags"), mg.Spec.InitProvider.Tags)...)
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type OAuthClientInitParameters struct {
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (Set of String) Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// +listType=set
+	Scopes []*string `json:"scopes,omitempty" tf:"scopes,omitempty"`
+
+	// (Set of String) A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+type OAuthClientObservation struct {
+
+	// (String) The creation timestamp of the key in RFC3339 format
+	// The creation timestamp of the key in RFC3339 format
+	CreatedAt *string `json:"createdAt,omitempty" tf:"created_at,omitempty"`
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Set of String) Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// +listType=set
+	Scopes []*string `json:"scopes,omitempty" tf:"scopes,omitempty"`
+
+	// (Set of String) A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+
+	// (String) ID of the user who created this key, empty for OAuth clients created by other OAuth clients.
+	// ID of the user who created this key, empty for OAuth clients created by other OAuth clients.
+	UserID *string `json:"userId,omitempty" tf:"user_id,omitempty"`
+}
+
+type OAuthClientParameters struct {
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// +kubebuilder:validation:Optional
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (Set of String) Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// Scopes to grant to the client. See https://tailscale.com/kb/1215/ for a list of available scopes.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Scopes []*string `json:"scopes,omitempty" tf:"scopes,omitempty"`
+
+	// (Set of String) A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// A list of tags that access tokens generated for the OAuth client will be able to assign to devices. Mandatory if the scopes include "devices:core" or "auth_keys".
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+// OAuthClientSpec defines the desired state of OAuthClient
+type OAuthClientSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     OAuthClientParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider OAuthClientInitParameters `json:"initProvider,omitempty"`
+}
+
+// OAuthClientStatus defines the observed state of OAuthClient.
+type OAuthClientStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        OAuthClientObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// OAuthClient is the Schema for the OAuthClients API. The oauth_client resource allows you to create OAuth clients to programmatically interact with the Tailscale API.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type OAuthClient struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.scopes) || (has(self.initProvider) && has(self.initProvider.scopes))",message="spec.forProvider.scopes is a required parameter"
+	Spec   OAuthClientSpec   `json:"spec"`
+	Status OAuthClientStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OAuthClientList contains a list of OAuthClients
+type OAuthClientList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OAuthClient `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	OAuthClient_Kind             = "OAuthClient"
+	OAuthClient_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OAuthClient_Kind}.String()
+	OAuthClient_KindAPIVersion   = OAuthClient_Kind + "." + CRDGroupVersion.String()
+	OAuthClient_GroupVersionKind = CRDGroupVersion.WithKind(OAuthClient_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OAuthClient{}, &OAuthClientList{})
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type PostureIntegrationInitParameters struct {
+
+	// (String) Unique identifier for your client.
+	// Unique identifier for your client.
+	ClientID *string `json:"clientId,omitempty" tf:"client_id,omitempty"`
+
+	// (String, Sensitive) The secret (auth key, token, etc.) used to authenticate with the provider.
+	// The secret (auth key, token, etc.) used to authenticate with the provider.
+	ClientSecretSecretRef v1.SecretKeySelector `json:"clientSecretSecretRef" tf:"-"`
+
+	// (String) Identifies which of the provider's clouds to integrate with.
+	// Identifies which of the provider's clouds to integrate with.
+	CloudID *string `json:"cloudId,omitempty" tf:"cloud_id,omitempty"`
+
+	// (String) The type of posture integration data provider.
+	// The type of posture integration data provider.
+	PostureProvider *string `json:"postureProvider,omitempty" tf:"posture_provider,omitempty"`
+
+	// (String) The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	// The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	TenantID *string `json:"tenantId,omitempty" tf:"tenant_id,omitempty"`
+}
+
+type PostureIntegrationObservation struct {
+
+	// (String) Unique identifier for your client.
+	// Unique identifier for your client.
+	ClientID *string `json:"clientId,omitempty" tf:"client_id,omitempty"`
+
+	// (String) Identifies which of the provider's clouds to integrate with.
+	// Identifies which of the provider's clouds to integrate with.
+	CloudID *string `json:"cloudId,omitempty" tf:"cloud_id,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (String) The type of posture integration data provider.
+	// The type of posture integration data provider.
+	PostureProvider *string `json:"postureProvider,omitempty" tf:"posture_provider,omitempty"`
+
+	// (String) The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	// The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	TenantID *string `json:"tenantId,omitempty" tf:"tenant_id,omitempty"`
+}
+
+type PostureIntegrationParameters struct {
+
+	// (String) Unique identifier for your client.
+	// Unique identifier for your client.
+	// +kubebuilder:validation:Optional
+	ClientID *string `json:"clientId,omitempty" tf:"client_id,omitempty"`
+
+	// (String, Sensitive) The secret (auth key, token, etc.) used to authenticate with the provider.
+	// The secret (auth key, token, etc.) used to authenticate with the provider.
+	// +kubebuilder:validation:Optional
+	ClientSecretSecretRef v1.SecretKeySelector `json:"clientSecretSecretRef" tf:"-"`
+
+	// (String) Identifies which of the provider's clouds to integrate with.
+	// Identifies which of the provider's clouds to integrate with.
+	// +kubebuilder:validation:Optional
+	CloudID *string `json:"cloudId,omitempty" tf:"cloud_id,omitempty"`
+
+	// (String) The type of posture integration data provider.
+	// The type of posture integration data provider.
+	// +kubebuilder:validation:Optional
+	PostureProvider *string `json:"postureProvider,omitempty" tf:"posture_provider,omitempty"`
+
+	// (String) The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	// The Microsoft Intune directory (tenant) ID. For other providers, this is left blank.
+	// +kubebuilder:validation:Optional
+	TenantID *string `json:"tenantId,omitempty" tf:"tenant_id,omitempty"`
+}
+
+// PostureIntegrationSpec defines the desired state of PostureIntegration
+type PostureIntegrationSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     PostureIntegrationParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider PostureIntegrationInitParameters `json:"initProvider,omitempty"`
+}
+
+// PostureIntegrationStatus defines the observed state of PostureIntegration.
+type PostureIntegrationStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        PostureIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// PostureIntegration is the Schema for the PostureIntegrations API. The posture_integration resource allows you to manage integrations with device posture data providers. See https://tailscale.com/kb/1288/device-posture for more information.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type PostureIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.clientSecretSecretRef)",message="spec.forProvider.clientSecretSecretRef is a required parameter"
+	// +kubebuilder:validation:XValidation:rule="!('*' in self.managementPolicies || 'Create' in self.managementPolicies || 'Update' in self.managementPolicies) || has(self.forProvider.postureProvider) || (has(self.initProvider) && has(self.initProvider.postureProvider))",message="spec.forProvider.postureProvider is a required parameter"
+	Spec   PostureIntegrationSpec   `json:"spec"`
+	Status PostureIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostureIntegrationList contains a list of PostureIntegrations
+type PostureIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostureIntegration `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	PostureIntegration_Kind             = "PostureIntegration"
+	PostureIntegration_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PostureIntegration_Kind}.String()
+	PostureIntegration_KindAPIVersion   = PostureIntegration_Kind + "." + CRDGroupVersion.String()
+	PostureIntegration_GroupVersionKind = CRDGroupVersion.WithKind(PostureIntegration_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PostureIntegration{}, &PostureIntegrationList{})
+}
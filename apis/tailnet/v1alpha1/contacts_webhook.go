@@ -0,0 +1,55 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &Contacts{}
+
+// ValidateCreate rejects a Contacts with contacts that are not email addresses.
+func (mg *Contacts) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a Contacts with contacts that are not email addresses.
+func (mg *Contacts) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any Contacts.
+func (mg *Contacts) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *Contacts) validate() field.ErrorList {
+	var errs field.ErrorList
+	fp := field.NewPath("spec", "forProvider")
+	for i, c := range mg.Spec.ForProvider.Account {
+		errs = append(errs, validation.Email(fp.Child("account").Index(i).Child("email"), c.Email)...)
+	}
+	for i, c := range mg.Spec.ForProvider.Security {
+		errs = append(errs, validation.Email(fp.Child("security").Index(i).Child("email"), c.Email)...)
+	}
+	for i, c := range mg.Spec.ForProvider.Support {
+		errs = append(errs, validation.Email(fp.Child("support").Index(i).Child("email"), c.Email)...)
+	}
+	ip := field.NewPath("spec", "initProvider")
+	for i, c := range mg.Spec.InitProvider.Account {
+		errs = append(errs, validation.Email(ip.Child("account").Index(i).Child("email"), c.Email)...)
+	}
+	for i, c := range mg.Spec.InitProvider.Security {
+		errs = append(errs, validation.Email(ip.Child("security").Index(i).Child("email"), c.Email)...)
+	}
+	for i, c := range mg.Spec.InitProvider.Support {
+		errs = append(errs, validation.Email(ip.Child("support").Index(i).Child("email"), c.Email)...)
+	}
+	return errs
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/internal/validation"
+)
+
+var _ admission.Validator = &TailnetKey{}
+
+// ValidateCreate rejects a TailnetKey with tags that lack the tag: prefix or
+// an expiry that is not a whole number of seconds within 90 days.
+func (mg *TailnetKey) ValidateCreate() (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateUpdate rejects a TailnetKey with tags that lack the tag: prefix or
+// an expiry that is not a whole number of seconds within 90 days.
+func (mg *TailnetKey) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, mg.validate().ToAggregate()
+}
+
+// ValidateDelete accepts the deletion of any TailnetKey.
+func (mg *TailnetKey) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (mg *TailnetKey) validate() field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := validation.Tags(spec.Child("forProvider", "tags"), mg.Spec.ForProvider.Tags)
+	errs = append(errs, validation.Tags(spec.Child("initProvider", "tags"), mg.Spec.InitProvider.Tags)...)
+	errs = append(errs, validation.Expiry(spec.Child("forProvider", "expiry"), mg.Spec.ForProvider.Expiry)...)
+	return append(errs, validation.Expiry(spec.Child("initProvider", "expiry"), mg.Spec.InitProvider.Expiry)...)
+}
@@ -0,0 +1,981 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountInitParameters) DeepCopyInto(out *AccountInitParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountInitParameters.
+func (in *AccountInitParameters) DeepCopy() *AccountInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountObservation) DeepCopyInto(out *AccountObservation) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountObservation.
+func (in *AccountObservation) DeepCopy() *AccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountParameters) DeepCopyInto(out *AccountParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountParameters.
+func (in *AccountParameters) DeepCopy() *AccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Contacts) DeepCopyInto(out *Contacts) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Contacts.
+func (in *Contacts) DeepCopy() *Contacts {
+	if in == nil {
+		return nil
+	}
+	out := new(Contacts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Contacts) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsInitParameters) DeepCopyInto(out *ContactsInitParameters) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = make([]AccountInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = make([]SecurityInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Support != nil {
+		in, out := &in.Support, &out.Support
+		*out = make([]SupportInitParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsInitParameters.
+func (in *ContactsInitParameters) DeepCopy() *ContactsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsList) DeepCopyInto(out *ContactsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Contacts, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsList.
+func (in *ContactsList) DeepCopy() *ContactsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContactsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsObservation) DeepCopyInto(out *ContactsObservation) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = make([]AccountObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = make([]SecurityObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Support != nil {
+		in, out := &in.Support, &out.Support
+		*out = make([]SupportObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsObservation.
+func (in *ContactsObservation) DeepCopy() *ContactsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsParameters) DeepCopyInto(out *ContactsParameters) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = make([]AccountParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = make([]SecurityParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Support != nil {
+		in, out := &in.Support, &out.Support
+		*out = make([]SupportParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsParameters.
+func (in *ContactsParameters) DeepCopy() *ContactsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsSpec) DeepCopyInto(out *ContactsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsSpec.
+func (in *ContactsSpec) DeepCopy() *ContactsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactsStatus) DeepCopyInto(out *ContactsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactsStatus.
+func (in *ContactsStatus) DeepCopy() *ContactsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityInitParameters) DeepCopyInto(out *SecurityInitParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityInitParameters.
+func (in *SecurityInitParameters) DeepCopy() *SecurityInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityObservation) DeepCopyInto(out *SecurityObservation) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityObservation.
+func (in *SecurityObservation) DeepCopy() *SecurityObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityParameters) DeepCopyInto(out *SecurityParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityParameters.
+func (in *SecurityParameters) DeepCopy() *SecurityParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportInitParameters) DeepCopyInto(out *SupportInitParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportInitParameters.
+func (in *SupportInitParameters) DeepCopy() *SupportInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportObservation) DeepCopyInto(out *SupportObservation) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportObservation.
+func (in *SupportObservation) DeepCopy() *SupportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportParameters) DeepCopyInto(out *SupportParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportParameters.
+func (in *SupportParameters) DeepCopy() *SupportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKey) DeepCopyInto(out *TailnetKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKey.
+func (in *TailnetKey) DeepCopy() *TailnetKey {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailnetKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeyInitParameters) DeepCopyInto(out *TailnetKeyInitParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Preauthorized != nil {
+		in, out := &in.Preauthorized, &out.Preauthorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecreateIfInvalid != nil {
+		in, out := &in.RecreateIfInvalid, &out.RecreateIfInvalid
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reusable != nil {
+		in, out := &in.Reusable, &out.Reusable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeyInitParameters.
+func (in *TailnetKeyInitParameters) DeepCopy() *TailnetKeyInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeyInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeyList) DeepCopyInto(out *TailnetKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TailnetKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeyList.
+func (in *TailnetKeyList) DeepCopy() *TailnetKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailnetKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeyObservation) DeepCopyInto(out *TailnetKeyObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Invalid != nil {
+		in, out := &in.Invalid, &out.Invalid
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Preauthorized != nil {
+		in, out := &in.Preauthorized, &out.Preauthorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecreateIfInvalid != nil {
+		in, out := &in.RecreateIfInvalid, &out.RecreateIfInvalid
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reusable != nil {
+		in, out := &in.Reusable, &out.Reusable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeyObservation.
+func (in *TailnetKeyObservation) DeepCopy() *TailnetKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeyParameters) DeepCopyInto(out *TailnetKeyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Preauthorized != nil {
+		in, out := &in.Preauthorized, &out.Preauthorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecreateIfInvalid != nil {
+		in, out := &in.RecreateIfInvalid, &out.RecreateIfInvalid
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reusable != nil {
+		in, out := &in.Reusable, &out.Reusable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeyParameters.
+func (in *TailnetKeyParameters) DeepCopy() *TailnetKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeySpec) DeepCopyInto(out *TailnetKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeySpec.
+func (in *TailnetKeySpec) DeepCopy() *TailnetKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetKeyStatus) DeepCopyInto(out *TailnetKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetKeyStatus.
+func (in *TailnetKeyStatus) DeepCopy() *TailnetKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettings) DeepCopyInto(out *TailnetSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettings.
+func (in *TailnetSettings) DeepCopy() *TailnetSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailnetSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsInitParameters) DeepCopyInto(out *TailnetSettingsInitParameters) {
+	*out = *in
+	if in.AclsExternalLink != nil {
+		in, out := &in.AclsExternalLink, &out.AclsExternalLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.AclsExternallyManagedOn != nil {
+		in, out := &in.AclsExternallyManagedOn, &out.AclsExternallyManagedOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesApprovalOn != nil {
+		in, out := &in.DevicesApprovalOn, &out.DevicesApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesAutoUpdatesOn != nil {
+		in, out := &in.DevicesAutoUpdatesOn, &out.DevicesAutoUpdatesOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesKeyDurationDays != nil {
+		in, out := &in.DevicesKeyDurationDays, &out.DevicesKeyDurationDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.NetworkFlowLoggingOn != nil {
+		in, out := &in.NetworkFlowLoggingOn, &out.NetworkFlowLoggingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostureIdentityCollectionOn != nil {
+		in, out := &in.PostureIdentityCollectionOn, &out.PostureIdentityCollectionOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegionalRoutingOn != nil {
+		in, out := &in.RegionalRoutingOn, &out.RegionalRoutingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersApprovalOn != nil {
+		in, out := &in.UsersApprovalOn, &out.UsersApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersRoleAllowedToJoinExternalTailnet != nil {
+		in, out := &in.UsersRoleAllowedToJoinExternalTailnet, &out.UsersRoleAllowedToJoinExternalTailnet
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsInitParameters.
+func (in *TailnetSettingsInitParameters) DeepCopy() *TailnetSettingsInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsList) DeepCopyInto(out *TailnetSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TailnetSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsList.
+func (in *TailnetSettingsList) DeepCopy() *TailnetSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TailnetSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsObservation) DeepCopyInto(out *TailnetSettingsObservation) {
+	*out = *in
+	if in.AclsExternalLink != nil {
+		in, out := &in.AclsExternalLink, &out.AclsExternalLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.AclsExternallyManagedOn != nil {
+		in, out := &in.AclsExternallyManagedOn, &out.AclsExternallyManagedOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesApprovalOn != nil {
+		in, out := &in.DevicesApprovalOn, &out.DevicesApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesAutoUpdatesOn != nil {
+		in, out := &in.DevicesAutoUpdatesOn, &out.DevicesAutoUpdatesOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesKeyDurationDays != nil {
+		in, out := &in.DevicesKeyDurationDays, &out.DevicesKeyDurationDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkFlowLoggingOn != nil {
+		in, out := &in.NetworkFlowLoggingOn, &out.NetworkFlowLoggingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostureIdentityCollectionOn != nil {
+		in, out := &in.PostureIdentityCollectionOn, &out.PostureIdentityCollectionOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegionalRoutingOn != nil {
+		in, out := &in.RegionalRoutingOn, &out.RegionalRoutingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersApprovalOn != nil {
+		in, out := &in.UsersApprovalOn, &out.UsersApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersRoleAllowedToJoinExternalTailnet != nil {
+		in, out := &in.UsersRoleAllowedToJoinExternalTailnet, &out.UsersRoleAllowedToJoinExternalTailnet
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsObservation.
+func (in *TailnetSettingsObservation) DeepCopy() *TailnetSettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsParameters) DeepCopyInto(out *TailnetSettingsParameters) {
+	*out = *in
+	if in.AclsExternalLink != nil {
+		in, out := &in.AclsExternalLink, &out.AclsExternalLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.AclsExternallyManagedOn != nil {
+		in, out := &in.AclsExternallyManagedOn, &out.AclsExternallyManagedOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesApprovalOn != nil {
+		in, out := &in.DevicesApprovalOn, &out.DevicesApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesAutoUpdatesOn != nil {
+		in, out := &in.DevicesAutoUpdatesOn, &out.DevicesAutoUpdatesOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DevicesKeyDurationDays != nil {
+		in, out := &in.DevicesKeyDurationDays, &out.DevicesKeyDurationDays
+		*out = new(float64)
+		**out = **in
+	}
+	if in.NetworkFlowLoggingOn != nil {
+		in, out := &in.NetworkFlowLoggingOn, &out.NetworkFlowLoggingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostureIdentityCollectionOn != nil {
+		in, out := &in.PostureIdentityCollectionOn, &out.PostureIdentityCollectionOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegionalRoutingOn != nil {
+		in, out := &in.RegionalRoutingOn, &out.RegionalRoutingOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersApprovalOn != nil {
+		in, out := &in.UsersApprovalOn, &out.UsersApprovalOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsersRoleAllowedToJoinExternalTailnet != nil {
+		in, out := &in.UsersRoleAllowedToJoinExternalTailnet, &out.UsersRoleAllowedToJoinExternalTailnet
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsParameters.
+func (in *TailnetSettingsParameters) DeepCopy() *TailnetSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsSpec) DeepCopyInto(out *TailnetSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsSpec.
+func (in *TailnetSettingsSpec) DeepCopy() *TailnetSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailnetSettingsStatus) DeepCopyInto(out *TailnetSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailnetSettingsStatus.
+func (in *TailnetSettingsStatus) DeepCopy() *TailnetSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TailnetSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
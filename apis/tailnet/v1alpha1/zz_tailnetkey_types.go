@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 The Crossplane Authors <https://crossplane.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by upjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+type TailnetKeyInitParameters struct {
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (Boolean) Indicates if the key is ephemeral. Defaults to `false`.
+	// Indicates if the key is ephemeral. Defaults to `false`.
+	Ephemeral *bool `json:"ephemeral,omitempty" tf:"ephemeral,omitempty"`
+
+	// (Number) The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	// The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	Expiry *float64 `json:"expiry,omitempty" tf:"expiry,omitempty"`
+
+	// (Boolean) Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	// Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	Preauthorized *bool `json:"preauthorized,omitempty" tf:"preauthorized,omitempty"`
+
+	// use keys will not. Possible values: 'always', 'never'.
+	// Determines whether the key should be created again if it becomes invalid. By default, reusable keys will be recreated, but single-use keys will not. Possible values: 'always', 'never'.
+	RecreateIfInvalid *string `json:"recreateIfInvalid,omitempty" tf:"recreate_if_invalid,omitempty"`
+
+	// use. Defaults to `false`.
+	// Indicates if the key is reusable or single-use. Defaults to `false`.
+	Reusable *bool `json:"reusable,omitempty" tf:"reusable,omitempty"`
+
+	// (Set of String) Tags to apply to the machines authenticated by the key.
+	// Tags to apply to the machines authenticated by the key.
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+type TailnetKeyObservation struct {
+
+	// (String) The creation timestamp of the key in RFC3339 format
+	// The creation timestamp of the key in RFC3339 format
+	CreatedAt *string `json:"createdAt,omitempty" tf:"created_at,omitempty"`
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (Boolean) Indicates if the key is ephemeral. Defaults to `false`.
+	// Indicates if the key is ephemeral. Defaults to `false`.
+	Ephemeral *bool `json:"ephemeral,omitempty" tf:"ephemeral,omitempty"`
+
+	// (String) The expiry timestamp of the key in RFC3339 format
+	// The expiry timestamp of the key in RFC3339 format
+	ExpiresAt *string `json:"expiresAt,omitempty" tf:"expires_at,omitempty"`
+
+	// (Number) The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	// The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	Expiry *float64 `json:"expiry,omitempty" tf:"expiry,omitempty"`
+
+	// (String) The ID of this resource.
+	ID *string `json:"id,omitempty" tf:"id,omitempty"`
+
+	// (Boolean) Indicates whether the key is invalid (e.g. expired, revoked or has been deleted).
+	// Indicates whether the key is invalid (e.g. expired, revoked or has been deleted).
+	Invalid *bool `json:"invalid,omitempty" tf:"invalid,omitempty"`
+
+	// (Boolean) Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	// Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	Preauthorized *bool `json:"preauthorized,omitempty" tf:"preauthorized,omitempty"`
+
+	// use keys will not. Possible values: 'always', 'never'.
+	// Determines whether the key should be created again if it becomes invalid. By default, reusable keys will be recreated, but single-use keys will not. Possible values: 'always', 'never'.
+	RecreateIfInvalid *string `json:"recreateIfInvalid,omitempty" tf:"recreate_if_invalid,omitempty"`
+
+	// use. Defaults to `false`.
+	// Indicates if the key is reusable or single-use. Defaults to `false`.
+	Reusable *bool `json:"reusable,omitempty" tf:"reusable,omitempty"`
+
+	// (Set of String) Tags to apply to the machines authenticated by the key.
+	// Tags to apply to the machines authenticated by the key.
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+
+	// (String) ID of the user who created this key, empty for keys created by OAuth clients.
+	// ID of the user who created this key, empty for keys created by OAuth clients.
+	UserID *string `json:"userId,omitempty" tf:"user_id,omitempty"`
+}
+
+type TailnetKeyParameters struct {
+
+	// (String) A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// A description of the key consisting of alphanumeric characters. Defaults to `""`.
+	// +kubebuilder:validation:Optional
+	Description *string `json:"description,omitempty" tf:"description,omitempty"`
+
+	// (Boolean) Indicates if the key is ephemeral. Defaults to `false`.
+	// Indicates if the key is ephemeral. Defaults to `false`.
+	// +kubebuilder:validation:Optional
+	Ephemeral *bool `json:"ephemeral,omitempty" tf:"ephemeral,omitempty"`
+
+	// (Number) The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	// The expiry of the key in seconds. Defaults to `7776000` (90 days).
+	// +kubebuilder:validation:Optional
+	Expiry *float64 `json:"expiry,omitempty" tf:"expiry,omitempty"`
+
+	// (Boolean) Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	// Determines whether or not the machines authenticated by the key will be authorized for the tailnet by default. Defaults to `false`.
+	// +kubebuilder:validation:Optional
+	Preauthorized *bool `json:"preauthorized,omitempty" tf:"preauthorized,omitempty"`
+
+	// use keys will not. Possible values: 'always', 'never'.
+	// Determines whether the key should be created again if it becomes invalid. By default, reusable keys will be recreated, but single-use keys will not. Possible values: 'always', 'never'.
+	// +kubebuilder:validation:Optional
+	RecreateIfInvalid *string `json:"recreateIfInvalid,omitempty" tf:"recreate_if_invalid,omitempty"`
+
+	// use. Defaults to `false`.
+	// Indicates if the key is reusable or single-use. Defaults to `false`.
+	// +kubebuilder:validation:Optional
+	Reusable *bool `json:"reusable,omitempty" tf:"reusable,omitempty"`
+
+	// (Set of String) Tags to apply to the machines authenticated by the key.
+	// Tags to apply to the machines authenticated by the key.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Tags []*string `json:"tags,omitempty" tf:"tags,omitempty"`
+}
+
+// TailnetKeySpec defines the desired state of TailnetKey
+type TailnetKeySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     TailnetKeyParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider TailnetKeyInitParameters `json:"initProvider,omitempty"`
+}
+
+// TailnetKeyStatus defines the observed state of TailnetKey.
+type TailnetKeyStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        TailnetKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// TailnetKey is the Schema for the TailnetKeys API. The tailnet_key resource allows you to create pre-authentication keys that can register new nodes without needing to sign in via a web browser. See https://tailscale.com/kb/1085/auth-keys for more information
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,tailscale}
+type TailnetKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TailnetKeySpec   `json:"spec"`
+	Status            TailnetKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TailnetKeyList contains a list of TailnetKeys
+type TailnetKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TailnetKey `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	TailnetKey_Kind             = "TailnetKey"
+	TailnetKey_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TailnetKey_Kind}.String()
+	TailnetKey_KindAPIVersion   = TailnetKey_Kind + "." + CRDGroupVersion.String()
+	TailnetKey_GroupVersionKind = CRDGroupVersion.WithKind(TailnetKey_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TailnetKey{}, &TailnetKeyList{})
+}
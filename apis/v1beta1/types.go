@@ -14,6 +14,90 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Tailnet is the organization name of the tailnet to manage, e.g.
+	// example.com. It takes precedence over the tailnet read from
+	// Credentials, so that one credentials Secret can be shared by several
+	// ProviderConfigs. Defaults to the tailnet owning the credentials.
+	// +optional
+	Tailnet string `json:"tailnet,omitempty"`
+
+	// BaseURL is the base URL of the Tailscale API, e.g. that of a Headscale
+	// server or of a test environment. It takes precedence over the base URL
+	// read from Credentials. Defaults to https://api.tailscale.com.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s/?#]+[^\s]*$`
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// FallbackBaseURLs are further base URLs of the Tailscale API, e.g.
+	// those of other Headscale servers, that are tried in order when the
+	// credentials check cannot reach the base URL. Managed resources use the
+	// first one that answered the last check until the base URL answers
+	// again.
+	// +kubebuilder:validation:XValidation:rule="self.all(u, u.matches('^https?://[^\\\\s/?#]+[^\\\\s]*$'))",message="fallbackBaseURLs must be http or https URLs"
+	// +optional
+	FallbackBaseURLs []string `json:"fallbackBaseURLs,omitempty"`
+
+	// OAuth configures the provider to authenticate as a Tailscale OAuth
+	// client whose ID and secret are read from the referenced Secret keys.
+	// When set, it takes precedence over any API key or OAuth client read
+	// from Credentials, whose source may then be None. It is not used for
+	// managed resources that override their credentials.
+	// +optional
+	OAuth *ProviderOAuth `json:"oauth,omitempty"`
+
+	// ResourceCredentials allows managed resources using this ProviderConfig
+	// to read their credentials from another Secret through the
+	// tailscale.crossplane.io/credentials-secret-* annotations. Managed
+	// resources setting those annotations are rejected unless the Secret
+	// they reference is allowed here.
+	// +optional
+	ResourceCredentials *ResourceCredentials `json:"resourceCredentials,omitempty"`
+
+	// Policy constrains the managed resources using this ProviderConfig.
+	// +optional
+	Policy *ProviderPolicy `json:"policy,omitempty"`
+}
+
+// ProviderPolicy constrains the managed resources using a ProviderConfig.
+type ProviderPolicy struct {
+	// MaxReusableKeys caps how many reusable TailnetKeys using the
+	// ProviderConfig may exist. A reusable TailnetKey beyond the cap is not
+	// created, and reports a ReconcileError, until others are deleted.
+	// Keys that already exist in Tailscale are never affected.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReusableKeys *int64 `json:"maxReusableKeys,omitempty"`
+}
+
+// ResourceCredentials lists the Secrets managed resources may read their
+// credentials from instead of those of their ProviderConfig.
+type ResourceCredentials struct {
+	// AllowedSecrets are the Secrets managed resources may read their
+	// credentials from.
+	// +optional
+	AllowedSecrets []xpv1.SecretReference `json:"allowedSecrets,omitempty"`
+
+	// AllowedNamespaces are the namespaces of which managed resources may
+	// read their credentials from any Secret.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// ProviderOAuth configures authentication with a Tailscale OAuth client.
+type ProviderOAuth struct {
+	// ClientIDSecretRef references the Secret key holding the ID of the
+	// OAuth client.
+	ClientIDSecretRef xpv1.SecretKeySelector `json:"clientIdSecretRef"`
+
+	// ClientSecretSecretRef references the Secret key holding the secret of
+	// the OAuth client.
+	ClientSecretSecretRef xpv1.SecretKeySelector `json:"clientSecretSecretRef"`
+
+	// Scopes are the OAuth scopes requested for the access token, e.g.
+	// devices:core. See https://tailscale.com/kb/1215/oauth-clients#scopes.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -23,11 +107,25 @@ type ProviderCredentials struct {
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// AdditionalSecretRefs references further Secrets whose JSON credentials
+	// are merged into those read from Source, e.g. the API key from one
+	// Secret and the base URL and tailnet from another. Secrets are merged
+	// in order after Source, and when several of them set the same key the
+	// one listed last wins. They are not used for managed resources that
+	// override their credentials.
+	// +optional
+	AdditionalSecretRefs []xpv1.SecretKeySelector `json:"additionalSecretRefs,omitempty"`
 }
 
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// BaseURL is the base URL of the Tailscale API that answered the last
+	// credentials check.
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
 }
 
 // +kubebuilder:object:root=true
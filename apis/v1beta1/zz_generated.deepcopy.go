@@ -9,6 +9,7 @@
 package v1beta1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -75,6 +76,26 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.FallbackBaseURLs != nil {
+		in, out := &in.FallbackBaseURLs, &out.FallbackBaseURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OAuth != nil {
+		in, out := &in.OAuth, &out.OAuth
+		*out = new(ProviderOAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceCredentials != nil {
+		in, out := &in.ResourceCredentials, &out.ResourceCredentials
+		*out = new(ResourceCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ProviderPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -165,6 +186,11 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+	if in.AdditionalSecretRefs != nil {
+		in, out := &in.AdditionalSecretRefs, &out.AdditionalSecretRefs
+		*out = make([]v1.SecretKeySelector, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCredentials.
@@ -176,3 +202,70 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderOAuth) DeepCopyInto(out *ProviderOAuth) {
+	*out = *in
+	out.ClientIDSecretRef = in.ClientIDSecretRef
+	out.ClientSecretSecretRef = in.ClientSecretSecretRef
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderOAuth.
+func (in *ProviderOAuth) DeepCopy() *ProviderOAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderOAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderPolicy) DeepCopyInto(out *ProviderPolicy) {
+	*out = *in
+	if in.MaxReusableKeys != nil {
+		in, out := &in.MaxReusableKeys, &out.MaxReusableKeys
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderPolicy.
+func (in *ProviderPolicy) DeepCopy() *ProviderPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCredentials) DeepCopyInto(out *ResourceCredentials) {
+	*out = *in
+	if in.AllowedSecrets != nil {
+		in, out := &in.AllowedSecrets, &out.AllowedSecrets
+		*out = make([]v1.SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCredentials.
+func (in *ResourceCredentials) DeepCopy() *ResourceCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -10,17 +10,31 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
-	v1alpha1 "github.com/supahlab/provider-tailscale/apis/null/v1alpha1"
+	v1alpha1 "github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	v1alpha1device "github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+	v1alpha1dns "github.com/supahlab/provider-tailscale/apis/dns/v1alpha1"
+	v1alpha1logstream "github.com/supahlab/provider-tailscale/apis/logstream/v1alpha1"
+	v1alpha1oauth "github.com/supahlab/provider-tailscale/apis/oauth/v1alpha1"
+	v1alpha1posture "github.com/supahlab/provider-tailscale/apis/posture/v1alpha1"
+	v1alpha1tailnet "github.com/supahlab/provider-tailscale/apis/tailnet/v1alpha1"
 	v1alpha1apis "github.com/supahlab/provider-tailscale/apis/v1alpha1"
 	v1beta1 "github.com/supahlab/provider-tailscale/apis/v1beta1"
+	v1alpha1webhook "github.com/supahlab/provider-tailscale/apis/webhook/v1alpha1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
 		v1alpha1.SchemeBuilder.AddToScheme,
+		v1alpha1device.SchemeBuilder.AddToScheme,
+		v1alpha1dns.SchemeBuilder.AddToScheme,
+		v1alpha1logstream.SchemeBuilder.AddToScheme,
+		v1alpha1oauth.SchemeBuilder.AddToScheme,
+		v1alpha1posture.SchemeBuilder.AddToScheme,
+		v1alpha1tailnet.SchemeBuilder.AddToScheme,
 		v1alpha1apis.SchemeBuilder.AddToScheme,
 		v1beta1.SchemeBuilder.AddToScheme,
+		v1alpha1webhook.SchemeBuilder.AddToScheme,
 	)
 }
 
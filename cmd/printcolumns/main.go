@@ -0,0 +1,109 @@
+/*
+Copyright 2021 Upbound Inc.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/supahlab/provider-tailscale/config"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "" {
+		panic("CRD directory is required to be given as argument")
+	}
+	for name, columns := range config.PrinterColumns {
+		plural, group, _ := strings.Cut(name, ".")
+		if err := addColumns(filepath.Join(os.Args[1], group+"_"+plural+".yaml"), columns); err != nil {
+			panic(fmt.Sprintf("cannot add printer columns to CRD %s: %v", name, err))
+		}
+	}
+	for name, patterns := range config.FieldPatterns {
+		plural, group, _ := strings.Cut(name, ".")
+		if err := addPatterns(filepath.Join(os.Args[1], group+"_"+plural+".yaml"), patterns); err != nil {
+			panic(fmt.Sprintf("cannot add field patterns to CRD %s: %v", name, err))
+		}
+	}
+}
+
+// addPatterns sets the pattern of the fields at the supplied paths below
+// spec.forProvider and spec.initProvider of every version of the CRD in the
+// supplied file.
+func addPatterns(path string, patterns map[string]string) error {
+	return updateCRD(path, func(version map[string]any) error {
+		for _, params := range []string{"forProvider", "initProvider"} {
+			for field, pattern := range patterns {
+				node := property(version, "schema", "openAPIV3Schema", "properties", "spec", "properties", params)
+				for _, p := range strings.Split(field, ".") {
+					name, list := strings.CutSuffix(p, "[]")
+					node = property(node, "properties", name)
+					if list {
+						node = property(node, "items")
+					}
+				}
+				if node == nil {
+					return fmt.Errorf("version %v has no field spec.%s.%s", version["name"], params, field)
+				}
+				node["pattern"] = pattern
+			}
+		}
+		return nil
+	})
+}
+
+// property returns the object at the supplied path below m, or nil.
+func property(m map[string]any, path ...string) map[string]any {
+	for _, p := range path {
+		m, _ = m[p].(map[string]any)
+	}
+	return m
+}
+
+// addColumns inserts columns ahead of the last additional printer column,
+// AGE, of every version of the CRD in the supplied file.
+func addColumns(path string, columns []config.PrinterColumn) error {
+	return updateCRD(path, func(version map[string]any) error {
+		existing, _ := version["additionalPrinterColumns"].([]any)
+		if len(existing) == 0 {
+			return fmt.Errorf("version %v has no printer columns", version["name"])
+		}
+		merged := append([]any{}, existing[:len(existing)-1]...)
+		for _, c := range columns {
+			merged = append(merged, c)
+		}
+		version["additionalPrinterColumns"] = append(merged, existing[len(existing)-1])
+		return nil
+	})
+}
+
+// updateCRD applies fn to every version of the CRD in the supplied file.
+func updateCRD(path string, fn func(version map[string]any) error) error {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	crd := map[string]any{}
+	if err := yaml.Unmarshal(b, &crd); err != nil {
+		return err
+	}
+	spec, _ := crd["spec"].(map[string]any)
+	versions, _ := spec["versions"].([]any)
+	for _, v := range versions {
+		version, _ := v.(map[string]any)
+		if err := fn(version); err != nil {
+			return err
+		}
+	}
+	out, err := yaml.Marshal(crd)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte("---\n"), out...), 0o600)
+}
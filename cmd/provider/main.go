@@ -28,14 +28,19 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/supahlab/provider-tailscale/apis"
 	"github.com/supahlab/provider-tailscale/apis/v1alpha1"
 	"github.com/supahlab/provider-tailscale/config"
+	"github.com/supahlab/provider-tailscale/config/acl"
+	"github.com/supahlab/provider-tailscale/internal/admission"
 	"github.com/supahlab/provider-tailscale/internal/clients"
 	"github.com/supahlab/provider-tailscale/internal/controller"
+	"github.com/supahlab/provider-tailscale/internal/controller/providerconfig"
 	"github.com/supahlab/provider-tailscale/internal/features"
 )
 
@@ -45,21 +50,34 @@ func main() {
 		debug                   = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod              = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		pollInterval            = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("10m").Duration()
+		pollJitter              = app.Flag("poll-jitter", "If non-zero, varies the poll interval by a random amount of up to plus or minus this value.").Default("0s").Duration()
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
 		leaderElection          = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate        = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may be checked for drift from the desired state.").Default("10").Int()
+		healthProbeBindAddress  = app.Flag("health-probe-bind-address", "The address the /healthz and /readyz probe endpoints bind to.").Default(":8081").Envar("HEALTH_PROBE_BIND_ADDRESS").String()
+		profilingBindAddress    = app.Flag("profiling-bind-address", "If set, serve pprof profiles under /debug/pprof on this address, such as :6060.").Default("").Envar("PROFILING_BIND_ADDRESS").String()
 
-		terraformVersion = app.Flag("terraform-version", "Terraform version.").Required().Envar("TERRAFORM_VERSION").String()
-		providerSource   = app.Flag("terraform-provider-source", "Terraform provider source.").Required().Envar("TERRAFORM_PROVIDER_SOURCE").String()
-		providerVersion  = app.Flag("terraform-provider-version", "Terraform provider version.").Required().Envar("TERRAFORM_PROVIDER_VERSION").String()
+		terraformVersion   = app.Flag("terraform-version", "Terraform version.").Required().Envar("TERRAFORM_VERSION").String()
+		providerSource     = app.Flag("terraform-provider-source", "Terraform provider source.").Required().Envar("TERRAFORM_PROVIDER_SOURCE").String()
+		providerVersion    = app.Flag("terraform-provider-version", "Terraform provider version.").Required().Envar("TERRAFORM_PROVIDER_VERSION").String()
+		nativeProviderPath = app.Flag("terraform-native-provider-path", "Terraform native provider path for shared execution.").Default("").Envar("TERRAFORM_NATIVE_PROVIDER_PATH").String()
+		providerTTL        = app.Flag("terraform-provider-ttl", "If non-zero, share each Terraform provider process between managed resources for up to this many Terraform invocations before replacing it. Requires --terraform-native-provider-path.").Default("0").Int()
+		userAgentPrefix    = app.Flag("user-agent-prefix", "Identifier prepended to the User-Agent of every Tailscale API request, such as the cluster name.").Envar("USER_AGENT_PREFIX").String()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		enableAlphaResources       = app.Flag("enable-alpha-resources", "Enable the controllers of alpha managed resources, such as PostureIntegration.").Default("false").Envar("ENABLE_ALPHA_RESOURCES").Bool()
 		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+		webhookTLSCertDir          = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate that the admission webhook server serves. The webhooks are disabled if unset.").Envar("TLS_SERVER_CERTS_DIR").String()
+		warnUnownedDeviceTags      = app.Flag("warn-unowned-device-tags", "Warn when a DeviceTags tag is not declared in the tagOwners of any ACL using the same ProviderConfig. Requires the webhooks to be enabled.").Default("false").Envar("WARN_UNOWNED_DEVICE_TAGS").Bool()
+		logConfigurationKeys       = app.Flag("log-configuration-keys", "Log the recognized provider configuration keys and the credential sources of the default ProviderConfig, without their values, at startup.").Default("false").Envar("LOG_CONFIGURATION_KEYS").Bool()
 	)
 
 	kingpin.MustParse(app.Parse(os.Args[1:]))
+	if *providerTTL > 0 && *nativeProviderPath == "" {
+		kingpin.Fatalf("--terraform-provider-ttl requires --terraform-native-provider-path, the Terraform provider binary to share")
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-tailscale"))
@@ -70,7 +88,7 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String(), "poll-interval", pollInterval.String(), "max-reconcile-rate", *maxReconcileRate)
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "poll-interval", pollInterval.String(), "poll-jitter", pollJitter.String(), "max-reconcile-rate", *maxReconcileRate)
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
@@ -84,6 +102,11 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+		HealthProbeBindAddress:     *healthProbeBindAddress,
+		PprofBindAddress:           *profilingBindAddress,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *webhookTLSCertDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Tailscale APIs to scheme")
@@ -93,6 +116,17 @@ func main() {
 
 	metrics.Registry.MustRegister(metricRecorder)
 	metrics.Registry.MustRegister(stateMetrics)
+	metrics.Registry.MustRegister(clients.SetupTime, clients.APIRequests)
+
+	setupOpts := []clients.SetupOption{clients.WithUserAgentPrefix(*userAgentPrefix)}
+	if *providerTTL > 0 {
+		setupOpts = append(setupOpts, clients.WithProviderScheduler(terraform.NewSharedProviderScheduler(log, *providerTTL,
+			terraform.WithSharedProviderOptions(terraform.WithNativeProviderPath(*nativeProviderPath), terraform.WithNativeProviderName("registry.terraform.io/"+*providerSource), terraform.WithNativeProviderArgs("-debug")))))
+		log.Info("Sharing Terraform provider processes", "ttl", *providerTTL)
+	}
+
+	pc := config.GetProvider()
+	acl.ValidatePolicies(pc, clients.ValidateACL, clients.IsUnverified)
 
 	o := tjcontroller.Options{
 		Options: xpcontroller.Options{
@@ -107,11 +141,11 @@ func main() {
 				MRStateMetrics:          stateMetrics,
 			},
 		},
-		Provider: config.GetProvider(),
-		// use the following WorkspaceStoreOption to enable the shared gRPC mode
-		// terraform.WithProviderRunner(terraform.NewSharedProvider(log, os.Getenv("TERRAFORM_NATIVE_PROVIDER_PATH"), terraform.WithNativeProviderArgs("-debuggable")))
+		Provider:       pc,
 		WorkspaceStore: terraform.NewWorkspaceStore(log),
-		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion),
+		PollJitter:     *pollJitter,
+		StartWebhooks:  *webhookTLSCertDir != "",
+		SetupFn:        clients.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion, setupOpts...),
 	}
 
 	if *enableExternalSecretStores {
@@ -148,6 +182,30 @@ func main() {
 		log.Info("Beta feature enabled", "flag", features.EnableBetaManagementPolicies)
 	}
 
-	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Tailscale controllers")
+	if *enableAlphaResources {
+		o.Features.Enable(features.EnableAlphaResources)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaResources)
+	}
+	disabled := controller.DisabledResources(o)
+
+	if *logConfigurationKeys {
+		kingpin.FatalIfError(clients.LogConfigurationKeys(context.Background(), mgr.GetAPIReader(), log), "Cannot log provider configuration keys")
+	}
+
+	switch {
+	case *warnUnownedDeviceTags && !o.StartWebhooks:
+		log.Info("Not warning about unowned DeviceTags tags because the webhooks are disabled")
+	case *warnUnownedDeviceTags:
+		// Registered before the controllers so that it replaces the webhook
+		// the DeviceTags controller would register.
+		kingpin.FatalIfError(admission.SetupDeviceTags(mgr), "Cannot setup DeviceTags webhook")
+	}
+	kingpin.FatalIfError(controller.SetupGated(mgr, o, disabled), "Cannot setup Tailscale controllers")
+	kingpin.FatalIfError(providerconfig.SetupHealth(mgr, o, setupOpts...), "Cannot setup ProviderConfig health controller")
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add health check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("controllers", controller.ReadyzCheck(mgr, o.Provider, disabled)), "Cannot add readiness check")
+	if o.StartWebhooks {
+		kingpin.FatalIfError(mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()), "Cannot add webhook readiness check")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/internal/hujson"
+)
+
+// AnnotationKeyReportUnused is the annotation of an ACL that, when "true",
+// reports the groups and tags its policy declares but never references in
+// the TypeUnusedDeclarations condition.
+const AnnotationKeyReportUnused = "tailscale.crossplane.io/report-unused"
+
+// TypeUnusedDeclarations is the condition of an ACL reporting whether its
+// policy declares groups or tags it never references.
+const TypeUnusedDeclarations xpv1.ConditionType = "UnusedDeclarations"
+
+// Reasons an ACL does or does not have unused declarations.
+const (
+	ReasonUnusedDeclarations   xpv1.ConditionReason = "UnusedDeclarationsFound"
+	ReasonNoUnusedDeclarations xpv1.ConditionReason = "NoUnusedDeclarations"
+	ReasonAnalysisDisabled     xpv1.ConditionReason = "AnalysisDisabled"
+)
+
+const msgFmtUnused = "groups and tags not referenced by the policy: %s"
+
+// reportUnused returns an initializer that sets the TypeUnusedDeclarations
+// condition of ACLs annotated with AnnotationKeyReportUnused. The condition
+// is only a warning: the ACL is reconciled regardless, and policies that
+// cannot be parsed, which the Tailscale API rejects, are not analyzed.
+func reportUnused(_ client.Client) managed.Initializer {
+	return managed.InitializerFn(func(_ context.Context, mg resource.Managed) error {
+		if meta.WasDeleted(mg) {
+			return nil
+		}
+		if mg.GetAnnotations()[AnnotationKeyReportUnused] != "true" {
+			// Don't leave the result of an earlier analysis behind.
+			if mg.GetCondition(TypeUnusedDeclarations).Reason != "" {
+				mg.SetConditions(analysisDisabled())
+			}
+			return nil
+		}
+		policy, ok := policyOf(mg)
+		if !ok {
+			return nil
+		}
+		if names, err := unused([]byte(policy)); err == nil {
+			mg.SetConditions(unusedDeclarations(names))
+		}
+		return nil
+	})
+}
+
+// policyOf returns the desired policy of the supplied ACL or, if it leaves
+// the policy to initProvider, the observed one.
+func policyOf(mg resource.Managed) (string, bool) {
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return "", false
+	}
+	for _, path := range []string{"spec.forProvider.acl", "status.atProvider.acl"} {
+		if policy, err := p.GetString(path); err == nil {
+			return policy, true
+		}
+	}
+	return "", false
+}
+
+// unused returns the sorted groups and tags declared in the groups and
+// tagOwners sections of the supplied policy that no other part of the policy
+// references. Any string elsewhere in the policy, such as a rule's source or
+// destination, the owners of a tag or an auto approver, is a reference, and
+// so is a destination naming a group or tag followed by ports.
+func unused(policy []byte) ([]string, error) {
+	p := map[string]any{}
+	if err := hujson.Unmarshal(policy, &p); err != nil {
+		return nil, err
+	}
+	declared := map[string]bool{}
+	refs := map[string]bool{}
+	for k, v := range p {
+		switch k {
+		case "groups", "tagOwners":
+			d, _ := v.(map[string]any)
+			for name, e := range d {
+				declared[name] = true
+				references(e, refs)
+			}
+		default:
+			references(v, refs)
+		}
+	}
+
+	var names []string
+	for name := range declared {
+		if !refs[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// references adds every string in v, and every prefix of it ending before a
+// colon, to refs. The prefixes make "tag:web:443" a reference to "tag:web".
+func references(v any, refs map[string]bool) {
+	switch v := v.(type) {
+	case string:
+		refs[v] = true
+		for i := range v {
+			if v[i] == ':' {
+				refs[v[:i]] = true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			references(e, refs)
+		}
+	case map[string]any:
+		for k, e := range v {
+			references(k, refs)
+			references(e, refs)
+		}
+	}
+}
+
+func analysisDisabled() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUnusedDeclarations,
+		Status:             corev1.ConditionUnknown,
+		Reason:             ReasonAnalysisDisabled,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+func unusedDeclarations(names []string) xpv1.Condition {
+	if len(names) == 0 {
+		return xpv1.Condition{
+			Type:               TypeUnusedDeclarations,
+			Status:             corev1.ConditionFalse,
+			Reason:             ReasonNoUnusedDeclarations,
+			LastTransitionTime: metav1.Now(),
+		}
+	}
+	return xpv1.Condition{
+		Type:               TypeUnusedDeclarations,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonUnusedDeclarations,
+		Message:            fmt.Sprintf(msgFmtUnused, strings.Join(names, ", ")),
+		LastTransitionTime: metav1.Now(),
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	"github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+)
+
+// usedPolicy references every group and tag it declares.
+const usedPolicy = `{
+  "groups": {
+    "group:eng": ["alice@example.com"],
+    "group:ops": ["bob@example.com"],
+  },
+  "tagOwners": {
+    "tag:web": ["group:ops"],
+    "tag:db":  ["tag:web"],
+  },
+  // Rules.
+  "acls": [
+    {"action": "accept", "src": ["group:eng"], "dst": ["tag:web:443"]},
+    {"action": "accept", "src": ["tag:web"], "dst": ["tag:db:5432"]},
+  ],
+}`
+
+// unusedPolicy declares a group and a tag that are never referenced.
+const unusedPolicy = `{
+  "groups": {
+    "group:eng":    ["alice@example.com"],
+    "group:former": ["carol@example.com"],
+  },
+  "tagOwners": {
+    "tag:web":    ["group:eng"],
+    "tag:legacy": ["group:eng"],
+  },
+  "acls": [
+    {"action": "accept", "src": ["group:eng"], "dst": ["tag:web:*"]},
+  ],
+}`
+
+func TestUnused(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		policy string
+		want   []string
+	}{
+		"FullyUsed": {
+			reason: "Groups and tags referenced by rules or as tag owners are used.",
+			policy: usedPolicy,
+		},
+		"Unused": {
+			reason: "Groups and tags referenced nowhere else in the policy are unused.",
+			policy: unusedPolicy,
+			want:   []string{"group:former", "tag:legacy"},
+		},
+		"PrefixIsNotReference": {
+			reason: "A tag is not referenced by another tag whose name it prefixes.",
+			policy: `{"tagOwners": {"tag:web": [], "tag:web-prod": []}, "acls": [{"action": "accept", "src": ["*"], "dst": ["tag:web-prod:443"]}]}`,
+			want:   []string{"tag:web"},
+		},
+		"AutoApprovers": {
+			reason: "Tags that auto approve routes are used.",
+			policy: `{"tagOwners": {"tag:router": []}, "autoApprovers": {"routes": {"10.0.0.0/8": ["tag:router"]}}}`,
+		},
+		"NoDeclarations": {
+			reason: "A policy without groups or tags has nothing unused.",
+			policy: `{"acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := unused([]byte(tc.policy))
+			if err != nil {
+				t.Fatalf("\n%s\nunused(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nunused(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReportUnused(t *testing.T) {
+	report := map[string]string{AnnotationKeyReportUnused: "true"}
+	newACL := func(annotations map[string]string, policy string, c ...xpv1.Condition) *v1alpha1.ACL {
+		mg := &v1alpha1.ACL{ObjectMeta: metav1.ObjectMeta{Name: "policy", Annotations: annotations}}
+		if policy != "" {
+			mg.Spec.ForProvider.ACL = &policy
+		}
+		mg.SetConditions(c...)
+		return mg
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.ACL
+		want   []xpv1.Condition
+	}{
+		"NotAnnotated": {
+			reason: "An ACL that does not ask for the analysis should not be analyzed.",
+			mg:     newACL(nil, unusedPolicy),
+		},
+		"FullyUsed": {
+			reason: "An ACL whose policy uses all its declarations should report none unused.",
+			mg:     newACL(report, usedPolicy),
+			want:   []xpv1.Condition{unusedDeclarations(nil)},
+		},
+		"Unused": {
+			reason: "An ACL whose policy has unused declarations should report them.",
+			mg:     newACL(report, unusedPolicy),
+			want:   []xpv1.Condition{unusedDeclarations([]string{"group:former", "tag:legacy"})},
+		},
+		"Disabled": {
+			reason: "An ACL that no longer asks for the analysis should not keep reporting its result.",
+			mg:     newACL(nil, unusedPolicy, unusedDeclarations([]string{"tag:legacy"})),
+			want:   []xpv1.Condition{analysisDisabled()},
+		},
+		"InvalidPolicy": {
+			reason: "An ACL whose policy cannot be parsed should be left to the Tailscale API.",
+			mg:     newACL(report, `{"acls": [`),
+		},
+		"NoPolicy": {
+			reason: "An ACL without a policy has nothing to analyze.",
+			mg:     newACL(report, ""),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := reportUnused(nil).Initialize(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\nInitialize(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, tc.mg.Status.Conditions, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want conditions, +got conditions:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestACLReconcileReportsUnused(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	policy := unusedPolicy
+	// The finalizer is already present, since adding it updates the ACL and
+	// so discards the status of the reconcile that adds it.
+	mg := &v1alpha1.ACL{ObjectMeta: metav1.ObjectMeta{
+		Name:        "policy",
+		Annotations: map[string]string{AnnotationKeyReportUnused: "true"},
+		Finalizers:  []string{"finalizer.managedresource.crossplane.io"},
+	}}
+	mg.Spec.ForProvider.ACL = &policy
+	c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(mg).WithStatusSubresource(mg).Build()
+
+	r := managed.NewReconciler(&fake.Manager{Client: c, Scheme: s}, resource.ManagedKind(v1alpha1.ACL_GroupVersionKind),
+		managed.WithInitializers(reportUnused(c)),
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+			}, nil
+		})),
+	)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "policy"}}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile(...): unexpected error: %v", err)
+	}
+
+	got := &v1alpha1.ACL{}
+	if err := c.Get(context.Background(), req.NamespacedName, got); err != nil {
+		t.Fatal(err)
+	}
+	want := xpv1.Condition{
+		Type:    TypeUnusedDeclarations,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonUnusedDeclarations,
+		Message: fmt.Sprintf(msgFmtUnused, "group:former, tag:legacy"),
+	}
+	if diff := cmp.Diff(want, got.GetCondition(TypeUnusedDeclarations), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("Reconcile(...): -want condition, +got condition:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.ReasonReconcileSuccess, got.GetCondition(xpv1.TypeSynced).Reason); diff != "" {
+		t.Errorf("Reconcile(...): the analysis should not keep the ACL from being reconciled: -want Synced reason, +got Synced reason:\n%s", diff)
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package acl
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "acl"
+
+// Configure configures the acl group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_acl", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "ACL"
+		// spec.forProvider.acl is passed to the Terraform provider verbatim,
+		// which accepts both JSON and HuJSON and compares policies in their
+		// standardized form, so comments and trailing commas in the spec do
+		// not show up as drift.
+		// The policy is never late-initialized, so that the remote policy
+		// and its server-side defaults are not written back into a spec
+		// that leaves it to initProvider or another source of truth.
+		r.LateInitializer = ujconfig.LateInitializer{
+			IgnoredFields: []string{"acl"},
+		}
+		r.InitializerFns = append(r.InitializerFns, reportUnused)
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package acl
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TypePolicyValid is the condition of an ACL reporting whether the Tailscale
+// API accepts its desired policy.
+const TypePolicyValid xpv1.ConditionType = "PolicyValid"
+
+// Reasons the desired policy of an ACL is or is not valid.
+const (
+	ReasonPolicyAccepted xpv1.ConditionReason = "PolicyAccepted"
+	ReasonPolicyRejected xpv1.ConditionReason = "PolicyRejected"
+)
+
+const (
+	errValidatePolicy    = "cannot validate the policy"
+	errFmtPolicyRejected = "the Tailscale API rejects the policy: %s"
+)
+
+// validateTimeout bounds a single policy validation.
+const validateTimeout = 30 * time.Second
+
+// A PolicyValidator asks the Tailscale API whether it accepts the supplied
+// policy of the supplied ACL. It returns why the API rejects the policy, or
+// "" if it accepts it.
+type PolicyValidator func(ctx context.Context, c client.Client, hc *http.Client, mg resource.Managed, policy string) (string, error)
+
+// ValidatePolicies makes the ACLs of the supplied provider validate their
+// desired policy with the supplied PolicyValidator whenever it differs from
+// the applied one, and refuse to apply a policy the Tailscale API rejects,
+// e.g. one whose tests fail. The result is reported in the TypePolicyValid
+// condition. Validations whose error the supplied unverified function
+// reports as unanswered are skipped and left to the apply. The validator is
+// supplied by the provider binary because the Tailscale API client depends
+// on the generated ProviderConfig types, which code generation cannot build.
+func ValidatePolicies(p *ujconfig.Provider, validate PolicyValidator, unverified func(error) bool) {
+	r := p.Resources["tailscale_acl"]
+	r.InitializerFns = append(r.InitializerFns, validatePolicy(validate, unverified, &http.Client{Timeout: validateTimeout}))
+}
+
+func validatePolicy(validate PolicyValidator, unverified func(error) bool, hc *http.Client) func(client.Client) managed.Initializer {
+	return func(c client.Client) managed.Initializer {
+		return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+			if meta.WasDeleted(mg) {
+				return nil
+			}
+			policy, ok := pendingPolicy(mg)
+			if !ok {
+				return nil
+			}
+
+			reason, err := validate(ctx, c, hc, mg, policy)
+			switch {
+			case unverified(err):
+				return nil
+			case err != nil:
+				return errors.Wrap(err, errValidatePolicy)
+			case reason != "":
+				mg.SetConditions(policyRejected(reason))
+				return errors.Errorf(errFmtPolicyRejected, reason)
+			}
+			mg.SetConditions(policyAccepted())
+			return nil
+		})
+	}
+}
+
+// pendingPolicy returns the desired policy of the supplied ACL if it differs
+// from the applied one.
+func pendingPolicy(mg resource.Managed) (string, bool) {
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return "", false
+	}
+	policy, err := p.GetString("spec.forProvider.acl")
+	if err != nil {
+		return "", false
+	}
+	applied, _ := p.GetString("status.atProvider.acl")
+	return policy, policy != applied
+}
+
+func policyAccepted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePolicyValid,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonPolicyAccepted,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+func policyRejected(reason string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePolicyValid,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonPolicyRejected,
+		Message:            reason,
+		LastTransitionTime: metav1.Now(),
+	}
+}
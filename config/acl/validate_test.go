@@ -0,0 +1,182 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package acl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	"github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/internal/clients"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	const failed = "test(s) failed; alice@example.com: address \"tag:web:443\": want: Accept, got: Drop"
+
+	newACL := func(desired, applied string, deleted bool) *v1alpha1.ACL {
+		mg := &v1alpha1.ACL{ObjectMeta: metav1.ObjectMeta{Name: "policy"}}
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+		if desired != "" {
+			mg.Spec.ForProvider.ACL = &desired
+		}
+		if applied != "" {
+			mg.Status.AtProvider.ACL = &applied
+		}
+		if deleted {
+			mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+		}
+		return mg
+	}
+
+	type args struct {
+		mg   *v1alpha1.ACL
+		code int
+		body string
+	}
+	type want struct {
+		err        error
+		conditions []xpv1.Condition
+		requests   int
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Accepted": {
+			reason: "A changed policy the API accepts should be reported as valid.",
+			args: args{
+				mg:   newACL(usedPolicy, unusedPolicy, false),
+				code: http.StatusOK,
+				body: `{}`,
+			},
+			want: want{
+				conditions: []xpv1.Condition{policyAccepted()},
+				requests:   1,
+			},
+		},
+		"Created": {
+			reason: "The policy of an ACL that was never applied should be validated.",
+			args: args{
+				mg:   newACL(usedPolicy, "", false),
+				code: http.StatusOK,
+				body: `{}`,
+			},
+			want: want{
+				conditions: []xpv1.Condition{policyAccepted()},
+				requests:   1,
+			},
+		},
+		"Rejected": {
+			reason: "A changed policy the API rejects should be reported as invalid and not be applied.",
+			args: args{
+				mg:   newACL(usedPolicy, unusedPolicy, false),
+				code: http.StatusOK,
+				body: `{"message":"test(s) failed","data":[{"user":"alice@example.com","errors":["address \"tag:web:443\": want: Accept, got: Drop"]}]}`,
+			},
+			want: want{
+				err:        errors.Errorf(errFmtPolicyRejected, failed),
+				conditions: []xpv1.Condition{policyRejected(failed)},
+				requests:   1,
+			},
+		},
+		"Unverified": {
+			reason: "A validation the API cannot answer should be left to the apply.",
+			args: args{
+				mg:   newACL(usedPolicy, unusedPolicy, false),
+				code: http.StatusServiceUnavailable,
+			},
+			want: want{
+				requests: 1,
+			},
+		},
+		"Unchanged": {
+			reason: "A policy that is already applied should not be validated again.",
+			args: args{
+				mg: newACL(usedPolicy, usedPolicy, false),
+			},
+		},
+		"NoPolicy": {
+			reason: "An ACL without a desired policy has nothing to validate.",
+			args: args{
+				mg: newACL("", usedPolicy, false),
+			},
+		},
+		"Deleted": {
+			reason: "The policy of a deleted ACL should not be validated.",
+			args: args{
+				mg: newACL(usedPolicy, unusedPolicy, true),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != "/api/v2/tailnet/-/acl/validate" {
+					t.Errorf("\n%s\nInitialize(...): unexpected request to %s", tc.reason, r.URL.Path)
+				}
+				w.WriteHeader(tc.args.code)
+				_, _ = w.Write([]byte(tc.args.body))
+			}))
+			defer srv.Close()
+
+			s := runtime.NewScheme()
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			pc := &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: v1beta1.ProviderConfigSpec{
+					BaseURL: srv.URL,
+					Credentials: v1beta1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "default"},
+								Key:             "credentials",
+							},
+						},
+					},
+				},
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "default"},
+				Data:       map[string][]byte{"credentials": []byte(`{"api_key":"tskey-api-test"}`)},
+			}
+			c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(pc, secret).Build()
+
+			err := validatePolicy(clients.ValidateACL, clients.IsUnverified, srv.Client())(c).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.args.mg.Status.Conditions, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want conditions, +got conditions:\n%s\n", tc.reason, diff)
+			}
+			if requests != tc.want.requests {
+				t.Errorf("\n%s\nInitialize(...): want %d requests, got %d", tc.reason, tc.want.requests, requests)
+			}
+		})
+	}
+}
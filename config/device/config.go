@@ -0,0 +1,38 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package device
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "device"
+
+// Configure configures the device group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_device_subnet_routes", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DeviceSubnetRoutes"
+		// The Terraform provider only exposes devices as data sources, so
+		// the node ID is resolved from the device's DeviceAuthorization.
+		r.References["device_id"] = ujconfig.Reference{
+			TerraformName: "tailscale_device_authorization",
+			Extractor:     `github.com/crossplane/upjet/pkg/resource.ExtractParamPath("device_id", false)`,
+		}
+	})
+	p.AddResourceConfigurator("tailscale_device_authorization", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DeviceAuthorization"
+	})
+	p.AddResourceConfigurator("tailscale_device_tags", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DeviceTags"
+		r.InitializerFns = append(r.InitializerFns, waitForACL)
+	})
+	p.AddResourceConfigurator("tailscale_device_key", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DeviceKey"
+	})
+}
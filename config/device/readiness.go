@@ -0,0 +1,62 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package device
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationKeyWaitForACL is the annotation of a DeviceTags naming the ACL
+// managed resource that declares its tags. The tags are not applied until
+// that ACL is Ready, since the Tailscale API rejects tags without owners.
+const AnnotationKeyWaitForACL = "tailscale.crossplane.io/wait-for-acl"
+
+const (
+	errFmtGetACL      = "cannot get ACL %s"
+	errFmtACLStatus   = "cannot read the status of ACL %s"
+	errFmtACLNotReady = "waiting for ACL %s to become ready"
+)
+
+// aclGVK is the kind of ACL managed resources. ACLs are read unstructured so
+// that the configuration does not depend on the generated API types.
+var aclGVK = schema.GroupVersionKind{Group: "acl.tailscale.com", Version: "v1alpha1", Kind: "ACL"}
+
+// waitForACL returns an initializer that fails, and so keeps the managed
+// resource from being observed or applied, while the ACL named by its
+// AnnotationKeyWaitForACL annotation does not exist or is not Ready.
+// Resources being deleted are never held back.
+func waitForACL(c client.Client) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		name := mg.GetAnnotations()[AnnotationKeyWaitForACL]
+		if name == "" || meta.WasDeleted(mg) {
+			return nil
+		}
+		acl := &unstructured.Unstructured{}
+		acl.SetGroupVersionKind(aclGVK)
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, acl); err != nil {
+			return errors.Wrapf(err, errFmtGetACL, name)
+		}
+		s := xpv1.ConditionedStatus{}
+		if err := fieldpath.Pave(acl.Object).GetValueInto("status", &s); resource.Ignore(fieldpath.IsNotFound, err) != nil {
+			return errors.Wrapf(err, errFmtACLStatus, name)
+		}
+		if s.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+			return errors.Errorf(errFmtACLNotReady, name)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package device
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	"github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+)
+
+// newACL returns an unstructured ACL with the supplied conditions.
+func newACL(name string, c ...xpv1.Condition) *unstructured.Unstructured {
+	acl := &unstructured.Unstructured{}
+	acl.SetGroupVersionKind(aclGVK)
+	acl.SetName(name)
+	if len(c) > 0 {
+		conditions := make([]any, 0, len(c))
+		for _, cond := range c {
+			conditions = append(conditions, map[string]any{
+				"type":               string(cond.Type),
+				"status":             string(cond.Status),
+				"reason":             string(cond.Reason),
+				"lastTransitionTime": cond.LastTransitionTime.UTC().Format("2006-01-02T15:04:05Z"),
+			})
+		}
+		acl.Object["status"] = map[string]any{"conditions": conditions}
+	}
+	return acl
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestWaitForACL(t *testing.T) {
+	waiting := map[string]string{AnnotationKeyWaitForACL: "policy"}
+	deleted := metav1.Now()
+
+	type args struct {
+		objs []client.Object
+		mg   resource.Managed
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoAnnotation": {
+			reason: "A resource that does not name an ACL should not wait.",
+			args: args{
+				mg: &fake.Managed{},
+			},
+		},
+		"ACLReady": {
+			reason: "A resource naming a Ready ACL should not wait.",
+			args: args{
+				objs: []client.Object{newACL("policy", xpv1.Available())},
+				mg:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: waiting}},
+			},
+		},
+		"ACLNotReady": {
+			reason: "A resource naming an ACL that is not Ready should wait.",
+			args: args{
+				objs: []client.Object{newACL("policy", xpv1.Creating())},
+				mg:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: waiting}},
+			},
+			want: errors.Errorf(errFmtACLNotReady, "policy"),
+		},
+		"ACLWithoutStatus": {
+			reason: "A resource naming an ACL that was never reconciled should wait.",
+			args: args{
+				objs: []client.Object{newACL("policy")},
+				mg:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: waiting}},
+			},
+			want: errors.Errorf(errFmtACLNotReady, "policy"),
+		},
+		"ACLNotFound": {
+			reason: "A resource naming an ACL that does not exist should wait.",
+			args: args{
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: waiting}},
+			},
+			want: errors.Wrapf(kerrors.NewNotFound(schema.GroupResource{Group: aclGVK.Group, Resource: "acls"}, "policy"), errFmtGetACL, "policy"),
+		},
+		"Deleted": {
+			reason: "A resource being deleted should never wait.",
+			args: args{
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: waiting, DeletionTimestamp: &deleted}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := clientfake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(tc.args.objs...).Build()
+			err := waitForACL(c).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeviceTagsReconcileWaitsForACL(t *testing.T) {
+	mg := &v1alpha1.DeviceTags{ObjectMeta: metav1.ObjectMeta{
+		Name:        "server",
+		Annotations: map[string]string{AnnotationKeyWaitForACL: "policy"},
+	}}
+	acl := newACL("policy", xpv1.Creating())
+	c := clientfake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(mg, acl).WithStatusSubresource(mg).Build()
+
+	observed := 0
+	r := managed.NewReconciler(&fake.Manager{Client: c, Scheme: c.Scheme()}, resource.ManagedKind(v1alpha1.DeviceTags_GroupVersionKind),
+		managed.WithInitializers(waitForACL(c)),
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					observed++
+					return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+			}, nil
+		})),
+	)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "server"}}
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile(...): unexpected error: %v", err)
+	}
+	if observed != 0 {
+		t.Errorf("Reconcile(...): DeviceTags was observed %d times while its ACL is not Ready", observed)
+	}
+	got := &v1alpha1.DeviceTags{}
+	if err := c.Get(context.Background(), req.NamespacedName, got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(xpv1.ReasonReconcileError, got.GetCondition(xpv1.TypeSynced).Reason); diff != "" {
+		t.Errorf("Reconcile(...): -want Synced reason, +got Synced reason:\n%s", diff)
+	}
+
+	// Once the ACL is Ready the DeviceTags is observed.
+	acl = newACL("policy", xpv1.Available())
+	if err := c.Update(context.Background(), withResourceVersion(t, c, acl)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile(...): unexpected error: %v", err)
+	}
+	if observed != 1 {
+		t.Errorf("Reconcile(...): DeviceTags was observed %d times after its ACL became Ready, want 1", observed)
+	}
+}
+
+// withResourceVersion sets the resource version of the stored copy of u on
+// u, so that u can replace it.
+func withResourceVersion(t *testing.T, c client.Client, u *unstructured.Unstructured) *unstructured.Unstructured {
+	t.Helper()
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(u.GroupVersionKind())
+	if err := c.Get(context.Background(), types.NamespacedName{Name: u.GetName()}, current); err != nil {
+		t.Fatal(err)
+	}
+	u.SetResourceVersion(current.GetResourceVersion())
+	return u
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package dns
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "dns"
+
+// Configure configures the dns group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_dns_nameservers", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DNSNameservers"
+		r.InitializerFns = append(r.InitializerFns, protectSplitNameservers)
+	})
+	p.AddResourceConfigurator("tailscale_dns_preferences", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DNSPreferences"
+	})
+	p.AddResourceConfigurator("tailscale_dns_search_paths", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DNSSearchPaths"
+	})
+	p.AddResourceConfigurator("tailscale_dns_split_nameservers", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "DNSSplitNameservers"
+	})
+}
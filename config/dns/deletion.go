@@ -0,0 +1,65 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package dns
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/internal/providerconfig"
+)
+
+const (
+	errListSplitNameservers = "cannot list DNSSplitNameservers"
+	errFmtSplitNameservers  = "cannot delete the tailnet's nameservers while DNSSplitNameservers using ProviderConfig %s still exist: %s"
+)
+
+// splitNameserversListGVK is the kind of DNSSplitNameservers lists.
+var splitNameserversListGVK = schema.GroupVersionKind{Group: "dns.tailscale.com", Version: "v1alpha1", Kind: "DNSSplitNameserversList"}
+
+// protectSplitNameservers returns an initializer that fails, and so keeps a
+// DNSNameservers from being deleted in Tailscale, while DNSSplitNameservers
+// using the same ProviderConfig still exist and are not being deleted
+// themselves. Removing the global nameservers first would leave their split
+// DNS domains without a fallback until they are deleted too.
+func protectSplitNameservers(c client.Client) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		if !meta.WasDeleted(mg) {
+			return nil
+		}
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(splitNameserversListGVK)
+		if err := c.List(ctx, l); err != nil {
+			return errors.Wrap(err, errListSplitNameservers)
+		}
+		pc := providerconfig.Name(mg.GetProviderConfigReference())
+		var remaining []string
+		for i := range l.Items {
+			s := &l.Items[i]
+			if s.GetDeletionTimestamp() != nil {
+				continue
+			}
+			ref, _, _ := unstructured.NestedString(s.Object, "spec", "providerConfigRef", "name")
+			if providerconfig.Name(&xpv1.Reference{Name: ref}) == pc {
+				remaining = append(remaining, s.GetName())
+			}
+		}
+		if len(remaining) > 0 {
+			sort.Strings(remaining)
+			return errors.Errorf(errFmtSplitNameservers, pc, strings.Join(remaining, ", "))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package dns
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/supahlab/provider-tailscale/apis"
+)
+
+// newSplitNameservers returns an unstructured DNSSplitNameservers using the
+// supplied ProviderConfig, or none if pc is empty.
+func newSplitNameservers(name, pc string, deleting bool) *unstructured.Unstructured {
+	s := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{}}}
+	s.SetAPIVersion("dns.tailscale.com/v1alpha1")
+	s.SetKind("DNSSplitNameservers")
+	s.SetName(name)
+	if pc != "" {
+		s.Object["spec"] = map[string]any{"providerConfigRef": map[string]any{"name": pc}}
+	}
+	if deleting {
+		now := metav1.Now()
+		s.SetDeletionTimestamp(&now)
+		s.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+	}
+	return s
+}
+
+func newNameservers(pc string, deleting bool) *fake.Managed {
+	mg := &fake.Managed{
+		ObjectMeta:               metav1.ObjectMeta{Name: "nameservers"},
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: pc}},
+	}
+	if deleting {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+	return mg
+}
+
+func TestProtectSplitNameservers(t *testing.T) {
+	type args struct {
+		objs []client.Object
+		mg   *fake.Managed
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NotDeleted": {
+			reason: "Nameservers that are not being deleted should always be reconciled.",
+			args: args{
+				objs: []client.Object{newSplitNameservers("corp", "default", false)},
+				mg:   newNameservers("default", false),
+			},
+		},
+		"NoSplitNameservers": {
+			reason: "Nameservers should be deleted if no split nameservers exist.",
+			args: args{
+				mg: newNameservers("default", true),
+			},
+		},
+		"SplitNameservers": {
+			reason: "Nameservers should not be deleted while split nameservers using the same ProviderConfig exist.",
+			args: args{
+				objs: []client.Object{
+					newSplitNameservers("lab", "default", false),
+					newSplitNameservers("corp", "", false),
+				},
+				mg: newNameservers("default", true),
+			},
+			want: errors.Errorf(errFmtSplitNameservers, "default", "corp, lab"),
+		},
+		"OtherProviderConfig": {
+			reason: "Split nameservers using another ProviderConfig, and so maybe another tailnet, should not block the deletion.",
+			args: args{
+				objs: []client.Object{newSplitNameservers("corp", "other", false)},
+				mg:   newNameservers("default", true),
+			},
+		},
+		"SplitNameserversDeleted": {
+			reason: "Split nameservers that are being deleted too should not block the deletion.",
+			args: args{
+				objs: []client.Object{newSplitNameservers("corp", "default", true)},
+				mg:   newNameservers("default", true),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(tc.args.objs...).Build()
+			err := protectSplitNameservers(c).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -4,13 +4,75 @@ Copyright 2022 Upbound Inc.
 
 package config
 
-import "github.com/crossplane/upjet/pkg/config"
+import (
+	"context"
+
+	"github.com/crossplane/upjet/pkg/config"
+)
 
 // ExternalNameConfigs contains all external name configurations for this
 // provider.
 var ExternalNameConfigs = map[string]config.ExternalName{
-	// Import requires using a randomly generated ID from provider: nl-2e21sda
-	"null_resource": config.IdentifierFromProvider,
+	// Singleton, import requires using the fixed ID acl
+	"tailscale_acl": fixedIdentifier("acl"),
+	// No import
+	"tailscale_aws_external_id": config.IdentifierFromProvider,
+	// Singleton, import requires using the fixed ID contacts
+	"tailscale_contacts": fixedIdentifier("contacts"),
+	// Import requires using the ID of the device: 123456789
+	"tailscale_device_authorization": parameterAsIdentifier("device_id"),
+	// Import requires using the ID of the device: 123456789
+	"tailscale_device_key": parameterAsIdentifier("device_id"),
+	// Import requires using the node ID of the device: nodeidCNTRL
+	"tailscale_device_subnet_routes": parameterAsIdentifier("device_id"),
+	// Import requires using the node ID of the device: nodeidCNTRL
+	"tailscale_device_tags": parameterAsIdentifier("device_id"),
+	// Singleton, import requires using the fixed ID dns_nameservers
+	"tailscale_dns_nameservers": fixedIdentifier("dns_nameservers"),
+	// Singleton, import requires using the fixed ID dns_preferences
+	"tailscale_dns_preferences": fixedIdentifier("dns_preferences"),
+	// Singleton, import requires using the fixed ID dns_search_paths
+	"tailscale_dns_search_paths": fixedIdentifier("dns_search_paths"),
+	// Import requires using the split DNS domain: example.com
+	"tailscale_dns_split_nameservers": parameterAsIdentifier("domain"),
+	// Import requires using the log type: configuration
+	"tailscale_logstream_configuration": parameterAsIdentifier("log_type"),
+	// Import requires using the OAuth client ID: k1234511CNTRL
+	"tailscale_oauth_client": config.IdentifierFromProvider,
+	// Import requires using the posture integration ID: pcBEPQ3CNTRL
+	"tailscale_posture_integration": config.IdentifierFromProvider,
+	// Import requires using the key ID generated by Tailscale: 123456789
+	"tailscale_tailnet_key": config.IdentifierFromProvider,
+	// Singleton, import requires using the fixed ID tailnet_settings
+	"tailscale_tailnet_settings": fixedIdentifier("tailnet_settings"),
+	// Import requires using the webhook endpoint ID: 123456789
+	"tailscale_webhook": config.IdentifierFromProvider,
+}
+
+// fixedIdentifier is used for resources that exist exactly once per tailnet,
+// such as its ACL. Their Terraform ID is always id, so the existing object
+// is adopted without setting the external name.
+func fixedIdentifier(id string) config.ExternalName {
+	e := config.IdentifierFromProvider
+	e.GetIDFn = func(context.Context, string, map[string]any, map[string]any) (string, error) {
+		return id, nil
+	}
+	return e
+}
+
+// parameterAsIdentifier is used for resources whose Terraform ID is the value
+// of one of their required arguments, such as the device ID of device
+// settings, so the existing object is adopted without setting the external
+// name.
+func parameterAsIdentifier(param string) config.ExternalName {
+	e := config.IdentifierFromProvider
+	e.GetIDFn = func(_ context.Context, externalName string, parameters map[string]any, _ map[string]any) (string, error) {
+		if v, ok := parameters[param].(string); ok && v != "" {
+			return v, nil
+		}
+		return externalName, nil
+	}
+	return e
 }
 
 // ExternalNameConfigurations applies all external name configs listed in the
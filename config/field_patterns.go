@@ -0,0 +1,32 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package config
+
+import "github.com/supahlab/provider-tailscale/internal/validation"
+
+// FieldPatterns are the patterns string parameters of managed resources must
+// match, by CRD name and by path below spec.forProvider and spec.initProvider.
+// A path element ending in [] selects the elements of a list. They are added
+// to the generated CRDs so that malformed values are rejected on admission,
+// like the webhooks do, even where the webhooks are not installed.
+var FieldPatterns = map[string]map[string]string{
+	"devicesubnetroutes.device.tailscale.com": {
+		"routes[]": validation.PatternCIDR,
+	},
+	"devicetags.device.tailscale.com": {
+		"tags[]": validation.PatternTag,
+	},
+	"oauthclients.oauth.tailscale.com": {
+		"tags[]": validation.PatternTag,
+	},
+	"tailnetkeys.tailnet.tailscale.com": {
+		"tags[]": validation.PatternTag,
+	},
+	"contacts.tailnet.tailscale.com": {
+		"account[].email":  validation.PatternEmail,
+		"security[].email": validation.PatternEmail,
+		"support[].email":  validation.PatternEmail,
+	},
+}
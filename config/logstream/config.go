@@ -0,0 +1,23 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package logstream
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "logstream"
+
+// Configure configures the logstream group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_logstream_configuration", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "LogstreamConfiguration"
+	})
+	p.AddResourceConfigurator("tailscale_aws_external_id", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "AWSExternalID"
+	})
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package oauth
+
+import (
+	"encoding/json"
+
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "oauth"
+
+// Configure configures the oauth group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_oauth_client", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "OAuthClient"
+		// Publish the client ID and secret, and a credentials document in
+		// the format ProviderConfigs read, so that the connection secret
+		// can be referenced directly by another ProviderConfig.
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			id, _ := attr["id"].(string)
+			secret, _ := attr["key"].(string)
+			if id == "" || secret == "" {
+				return conn, nil
+			}
+			creds, err := json.Marshal(map[string]string{
+				"oauth_client_id":     id,
+				"oauth_client_secret": secret,
+			})
+			if err != nil {
+				return nil, err
+			}
+			conn["client_id"] = []byte(id)
+			conn["client_secret"] = []byte(secret)
+			conn["credentials"] = creds
+			return conn, nil
+		}
+	})
+}
@@ -0,0 +1,19 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package posture
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "posture"
+
+// Configure configures the posture group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_posture_integration", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "PostureIntegration"
+	})
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2021 Upbound Inc.
+*/
+
+package config
+
+// PrinterColumn is an additional column kubectl get prints for a managed
+// resource.
+type PrinterColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	JSONPath string `json:"jsonPath"`
+	Priority int    `json:"priority,omitempty"`
+}
+
+// PrinterColumns are the additional printer columns of managed resources by
+// CRD name. They are added to the generated CRDs ahead of their AGE column.
+var PrinterColumns = map[string][]PrinterColumn{
+	"tailnetkeys.tailnet.tailscale.com": {
+		{Name: "REUSABLE", Type: "boolean", JSONPath: ".status.atProvider.reusable"},
+		{Name: "EPHEMERAL", Type: "boolean", JSONPath: ".status.atProvider.ephemeral"},
+		{Name: "EXPIRES", Type: "string", JSONPath: ".status.atProvider.expiresAt"},
+	},
+	"webhooks.webhook.tailscale.com": {
+		{Name: "ENDPOINT", Type: "string", JSONPath: ".status.atProvider.endpointUrl"},
+	},
+}
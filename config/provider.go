@@ -10,7 +10,14 @@ import (
 
 	ujconfig "github.com/crossplane/upjet/pkg/config"
 
-	"github.com/supahlab/provider-tailscale/config/null"
+	"github.com/supahlab/provider-tailscale/config/acl"
+	"github.com/supahlab/provider-tailscale/config/device"
+	"github.com/supahlab/provider-tailscale/config/dns"
+	"github.com/supahlab/provider-tailscale/config/logstream"
+	"github.com/supahlab/provider-tailscale/config/oauth"
+	"github.com/supahlab/provider-tailscale/config/posture"
+	"github.com/supahlab/provider-tailscale/config/tailnet"
+	"github.com/supahlab/provider-tailscale/config/webhook"
 )
 
 const (
@@ -18,6 +25,12 @@ const (
 	modulePath     = "github.com/supahlab/provider-tailscale"
 )
 
+// AlphaResources are the Terraform resources whose managed resources are only
+// reconciled when alpha resources are enabled.
+var AlphaResources = map[string]bool{
+	"tailscale_posture_integration": true,
+}
+
 //go:embed schema.json
 var providerSchema string
 
@@ -36,7 +49,14 @@ func GetProvider() *ujconfig.Provider {
 
 	for _, configure := range []func(provider *ujconfig.Provider){
 		// add custom config functions
-		null.Configure,
+		acl.Configure,
+		device.Configure,
+		dns.Configure,
+		logstream.Configure,
+		oauth.Configure,
+		posture.Configure,
+		tailnet.Configure,
+		webhook.Configure,
 	} {
 		configure(pc)
 	}
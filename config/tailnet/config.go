@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"sort"
+	"strings"
+
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "tailnet"
+
+// Configure configures the tailnet group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_tailnet_key", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "TailnetKey"
+		r.Sensitive.AdditionalConnectionDetailsFn = tailnetKeyConnectionDetails
+		r.InitializerFns = append(r.InitializerFns, capReusableKeys, selectConnectionDetails)
+	})
+	p.AddResourceConfigurator("tailscale_contacts", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "Contacts"
+	})
+	p.AddResourceConfigurator("tailscale_tailnet_settings", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "TailnetSettings"
+	})
+}
+
+// tailnetKeyConnectionDetails publishes the generated auth key under a stable
+// connection detail key, in addition to upjet's attribute.key, so that
+// workloads can consume it from the connection secret. The key's ID, expiry
+// and tags are published next to it if the TailnetKey selects them, the
+// tags separated by commas, so that consumers can tell which key they hold
+// and when it needs replacing.
+func tailnetKeyConnectionDetails(attr map[string]any) (map[string][]byte, error) {
+	conn := map[string][]byte{}
+	if v, ok := attr["key"].(string); ok && v != "" {
+		conn["key"] = []byte(v)
+	}
+	id, _ := attr["id"].(string)
+	for _, k := range selected.get(id) {
+		switch k {
+		case "id", "expires_at":
+			if v, ok := attr[k].(string); ok && v != "" {
+				conn[k] = []byte(v)
+			}
+		case "tags":
+			tags, _ := attr["tags"].([]any)
+			if len(tags) == 0 {
+				continue
+			}
+			s := make([]string, 0, len(tags))
+			for _, t := range tags {
+				if v, ok := t.(string); ok {
+					s = append(s, v)
+				}
+			}
+			// Tags are a set, so their order must not change the secret.
+			sort.Strings(s)
+			conn["tags"] = []byte(strings.Join(s, ","))
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTailnetKeyConnectionDetails(t *testing.T) {
+	created := map[string]any{
+		"key":        "tskey-auth-secret",
+		"id":         "kAbCdEf1CNTRL",
+		"expires_at": "2024-04-01T00:00:00Z",
+		"created_at": "2024-01-01T00:00:00Z",
+		"tags":       []any{"tag:server", "tag:ci"},
+		"reusable":   true,
+	}
+
+	cases := map[string]struct {
+		reason   string
+		selected []string
+		attr     map[string]any
+		want     map[string][]byte
+	}{
+		"Default": {
+			reason: "Only the key should be published if the TailnetKey selects no metadata.",
+			attr:   created,
+			want: map[string][]byte{
+				"key": []byte("tskey-auth-secret"),
+			},
+		},
+		"All": {
+			reason:   "The key, its ID, its expiry and its sorted tags should be published if selected.",
+			selected: []string{"id", "expires_at", "tags"},
+			attr:     created,
+			want: map[string][]byte{
+				"key":        []byte("tskey-auth-secret"),
+				"id":         []byte("kAbCdEf1CNTRL"),
+				"expires_at": []byte("2024-04-01T00:00:00Z"),
+				"tags":       []byte("tag:ci,tag:server"),
+			},
+		},
+		"Some": {
+			reason:   "Metadata the TailnetKey does not select should be omitted.",
+			selected: []string{"expires_at"},
+			attr:     created,
+			want: map[string][]byte{
+				"key":        []byte("tskey-auth-secret"),
+				"expires_at": []byte("2024-04-01T00:00:00Z"),
+			},
+		},
+		"NoTags": {
+			reason:   "A key without tags should not publish an empty tags detail.",
+			selected: []string{"id", "tags"},
+			attr: map[string]any{
+				"key":  "tskey-auth-secret",
+				"id":   "kAbCdEf1CNTRL",
+				"tags": []any{},
+			},
+			want: map[string][]byte{
+				"key": []byte("tskey-auth-secret"),
+				"id":  []byte("kAbCdEf1CNTRL"),
+			},
+		},
+		"NotCreated": {
+			reason: "Nothing should be published before the key is created.",
+			attr:   map[string]any{},
+			want:   map[string][]byte{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, _ := tc.attr["id"].(string)
+			selected.set(id, tc.selected)
+			t.Cleanup(func() { selected.set(id, nil) })
+
+			got, err := tailnetKeyConnectionDetails(tc.attr)
+			if err != nil {
+				t.Fatalf("\n%s\ntailnetKeyConnectionDetails(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntailnetKeyConnectionDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
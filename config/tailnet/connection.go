@@ -0,0 +1,85 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationKeyConnectionDetails is the annotation of a TailnetKey listing,
+// separated by commas, the metadata of the key to publish in its connection
+// secret next to the key itself: any of id, expires_at and tags.
+const AnnotationKeyConnectionDetails = "tailscale.crossplane.io/connection-details"
+
+const errFmtConnectionDetail = "annotation %s lists %q, which is none of id, expires_at and tags"
+
+// connectionDetailKeys are the connection details a TailnetKey may select.
+var connectionDetailKeys = map[string]bool{"id": true, "expires_at": true, "tags": true}
+
+// selected are the connection details the TailnetKeys reconciled by this
+// process selected, by the ID of their key. Upjet only passes the Terraform
+// state to tailnetKeyConnectionDetails, so selectConnectionDetails records
+// the selection of each TailnetKey before it is observed.
+var selected = &selections{ids: map[string][]string{}}
+
+type selections struct {
+	mu  sync.RWMutex
+	ids map[string][]string
+}
+
+func (s *selections) get(id string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ids[id]
+}
+
+func (s *selections) set(id string, keys []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(keys) == 0 {
+		delete(s.ids, id)
+		return
+	}
+	s.ids[id] = keys
+}
+
+// selectConnectionDetails returns an initializer that records the
+// connection details a TailnetKey selects through its
+// AnnotationKeyConnectionDetails annotation for tailnetKeyConnectionDetails.
+// A key's selection can only be recorded once it exists, so the connection
+// secret of a new key holds only the key until the key is observed.
+func selectConnectionDetails(_ client.Client) managed.Initializer {
+	return managed.InitializerFn(func(_ context.Context, mg resource.Managed) error {
+		id := meta.GetExternalName(mg)
+		if id == "" {
+			return nil
+		}
+		if meta.WasDeleted(mg) {
+			selected.set(id, nil)
+			return nil
+		}
+		var keys []string
+		for _, k := range strings.Split(mg.GetAnnotations()[AnnotationKeyConnectionDetails], ",") {
+			k = strings.TrimSpace(k)
+			if k == "" {
+				continue
+			}
+			if !connectionDetailKeys[k] {
+				return errors.Errorf(errFmtConnectionDetail, AnnotationKeyConnectionDetails, k)
+			}
+			keys = append(keys, k)
+		}
+		selected.set(id, keys)
+		return nil
+	})
+}
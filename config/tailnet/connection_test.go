@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelectConnectionDetails(t *testing.T) {
+	const id = "kAbCdEf1CNTRL"
+	newKey := func(externalName, annotation string, deleted bool) *fake.Managed {
+		mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "key"}}
+		if annotation != "" {
+			meta.AddAnnotations(mg, map[string]string{AnnotationKeyConnectionDetails: annotation})
+		}
+		if externalName != "" {
+			meta.SetExternalName(mg, externalName)
+		}
+		if deleted {
+			mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+		}
+		return mg
+	}
+
+	type want struct {
+		err      error
+		selected []string
+	}
+	cases := map[string]struct {
+		reason   string
+		previous []string
+		mg       *fake.Managed
+		want     want
+	}{
+		"Selected": {
+			reason: "The connection details a key lists should be recorded.",
+			mg:     newKey(id, "id, tags", false),
+			want:   want{selected: []string{"id", "tags"}},
+		},
+		"Unselected": {
+			reason:   "A key that no longer lists connection details should no longer publish any.",
+			previous: []string{"id"},
+			mg:       newKey(id, "", false),
+		},
+		"Unknown": {
+			reason:   "A key listing an unknown connection detail should be rejected and keep its selection.",
+			previous: []string{"id"},
+			mg:       newKey(id, "id,key", false),
+			want: want{
+				err:      errors.Errorf(errFmtConnectionDetail, AnnotationKeyConnectionDetails, "key"),
+				selected: []string{"id"},
+			},
+		},
+		"NotCreated": {
+			reason: "Nothing should be recorded for a key that does not exist yet.",
+			mg:     newKey("", "id", false),
+		},
+		"Deleted": {
+			reason:   "The selection of a deleted key should be forgotten.",
+			previous: []string{"id"},
+			mg:       newKey(id, "id", true),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			selected.set(id, tc.previous)
+			t.Cleanup(func() { selected.set(id, nil) })
+
+			err := selectConnectionDetails(nil).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.selected, selected.get(id)); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want selection, +got selection:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/internal/providerconfig"
+)
+
+const (
+	errFmtGetProviderConfig = "cannot get ProviderConfig %s"
+	errListTailnetKeys      = "cannot list TailnetKeys"
+	errFmtReusableKeys      = "ProviderConfig %s allows at most %d reusable TailnetKeys, which already exist: %s"
+)
+
+var (
+	providerConfigGVK = schema.GroupVersionKind{Group: "tailscale.tailscale.com", Version: "v1beta1", Kind: "ProviderConfig"}
+	tailnetKeyListGVK = schema.GroupVersionKind{Group: "tailnet.tailscale.com", Version: "v1alpha1", Kind: "TailnetKeyList"}
+)
+
+// capReusableKeys returns an initializer that fails, and so keeps a reusable
+// TailnetKey from being created, while the spec.policy.maxReusableKeys of
+// its ProviderConfig is already taken by other reusable TailnetKeys. Keys
+// that exist in Tailscale, i.e. have an external name, take the cap first,
+// followed by those that are pending in the order they were created, so
+// that keys created together do not hold each other back. A key that exists
+// in Tailscale, or is being deleted, is never held back.
+func capReusableKeys(c client.Client) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		if meta.WasDeleted(mg) || meta.GetExternalName(mg) != "" || !reusable(mg) {
+			return nil
+		}
+		name := providerconfig.Name(mg.GetProviderConfigReference())
+		pc := &unstructured.Unstructured{}
+		pc.SetGroupVersionKind(providerConfigGVK)
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+			return errors.Wrapf(err, errFmtGetProviderConfig, name)
+		}
+		limit, ok, _ := unstructured.NestedInt64(pc.Object, "spec", "policy", "maxReusableKeys")
+		if !ok {
+			return nil
+		}
+
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(tailnetKeyListGVK)
+		if err := c.List(ctx, l); err != nil {
+			return errors.Wrap(err, errListTailnetKeys)
+		}
+		var ahead []string
+		for i := range l.Items {
+			k := &l.Items[i]
+			if k.GetName() == mg.GetName() || k.GetDeletionTimestamp() != nil || !reusable(k) {
+				continue
+			}
+			ref, _, _ := unstructured.NestedString(k.Object, "spec", "providerConfigRef", "name")
+			if providerconfig.Name(&xpv1.Reference{Name: ref}) != name {
+				continue
+			}
+			if meta.GetExternalName(k) != "" || createdBefore(k, mg) {
+				ahead = append(ahead, k.GetName())
+			}
+		}
+		if int64(len(ahead)) >= limit {
+			sort.Strings(ahead)
+			return errors.Errorf(errFmtReusableKeys, name, limit, strings.Join(ahead, ", "))
+		}
+		return nil
+	})
+}
+
+// reusable returns true if the supplied TailnetKey is, or will be created
+// as, a reusable key.
+func reusable(o runtime.Object) bool {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return false
+	}
+	for _, path := range []string{"spec.forProvider.reusable", "spec.initProvider.reusable"} {
+		if r, err := p.GetBool(path); err == nil {
+			return r
+		}
+	}
+	return false
+}
+
+// createdBefore returns true if a was created before b, comparing the names
+// of objects created in the same second.
+func createdBefore(a, b metav1.Object) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
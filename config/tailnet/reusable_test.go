@@ -0,0 +1,154 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package tailnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	"github.com/supahlab/provider-tailscale/apis/tailnet/v1alpha1"
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newKey returns a reusable TailnetKey using the supplied ProviderConfig,
+// created the supplied number of minutes after epoch.
+func newKey(name, pc string, minute int, mods ...func(k *v1alpha1.TailnetKey)) *v1alpha1.TailnetKey {
+	k := &v1alpha1.TailnetKey{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		CreationTimestamp: metav1.NewTime(epoch.Add(time.Duration(minute) * time.Minute)),
+	}}
+	k.Spec.ForProvider.Reusable = ptr.To(true)
+	k.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	for _, m := range mods {
+		m(k)
+	}
+	return k
+}
+
+func created(k *v1alpha1.TailnetKey) {
+	meta.SetExternalName(k, "k"+k.GetName()+"CNTRL")
+}
+
+func singleUse(k *v1alpha1.TailnetKey) {
+	k.Spec.ForProvider.Reusable = ptr.To(false)
+}
+
+func deleting(k *v1alpha1.TailnetKey) {
+	now := metav1.Now()
+	k.SetDeletionTimestamp(&now)
+	k.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+}
+
+func newProviderConfig(name string, limit *int64) *v1beta1.ProviderConfig {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if limit != nil {
+		pc.Spec.Policy = &v1beta1.ProviderPolicy{MaxReusableKeys: limit}
+	}
+	return pc
+}
+
+func TestCapReusableKeys(t *testing.T) {
+	type args struct {
+		objs []client.Object
+		mg   *v1alpha1.TailnetKey
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoCap": {
+			reason: "Reusable keys should not be capped if the ProviderConfig sets no cap.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", nil), newKey("a", "default", 0, created)},
+				mg:   newKey("b", "default", 1),
+			},
+		},
+		"UnderCap": {
+			reason: "A reusable key should be created while the cap is not reached.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](2)), newKey("a", "default", 0, created)},
+				mg:   newKey("b", "default", 1),
+			},
+		},
+		"OverCap": {
+			reason: "A reusable key should not be created once the cap is reached.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](2)), newKey("a", "default", 0, created), newKey("c", "default", 2, created)},
+				mg:   newKey("b", "default", 1),
+			},
+			want: errors.Errorf(errFmtReusableKeys, "default", 2, "a, c"),
+		},
+		"PendingCreatedEarlier": {
+			reason: "Pending keys created earlier should take the cap first.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](1)), newKey("a", "default", 0), newKey("c", "default", 2)},
+				mg:   newKey("b", "default", 1),
+			},
+			want: errors.Errorf(errFmtReusableKeys, "default", 1, "a"),
+		},
+		"PendingCreatedLater": {
+			reason: "Pending keys created later should not hold back a key.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](1)), newKey("c", "default", 2)},
+				mg:   newKey("b", "default", 1),
+			},
+		},
+		"Uncounted": {
+			reason: "Single-use keys, keys being deleted and keys of other ProviderConfigs should not count towards the cap.",
+			args: args{
+				objs: []client.Object{
+					newProviderConfig("default", ptr.To[int64](1)),
+					newKey("a", "default", 0, created, singleUse),
+					newKey("c", "default", 0, created, deleting),
+					newKey("d", "other", 0, created),
+				},
+				mg: newKey("b", "default", 1),
+			},
+		},
+		"SingleUse": {
+			reason: "A single-use key should never be capped.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](0))},
+				mg:   newKey("b", "default", 1, singleUse),
+			},
+		},
+		"Exists": {
+			reason: "A key that already exists in Tailscale should never be held back.",
+			args: args{
+				objs: []client.Object{newProviderConfig("default", ptr.To[int64](0))},
+				mg:   newKey("b", "default", 1, created),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(append(tc.args.objs, tc.args.mg)...).Build()
+			err := capReusableKeys(c).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
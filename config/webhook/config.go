@@ -0,0 +1,28 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package webhook
+
+import (
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+)
+
+const shortGroup = "webhook"
+
+// Configure configures the webhook group
+func Configure(p *ujconfig.Provider) {
+	p.AddResourceConfigurator("tailscale_webhook", func(r *ujconfig.Resource) {
+		r.ShortGroup = shortGroup
+		r.Kind = "Webhook"
+		// Publish the signing secret under a stable connection detail key
+		// so that receiving services can verify webhook payloads.
+		r.Sensitive.AdditionalConnectionDetailsFn = func(attr map[string]any) (map[string][]byte, error) {
+			conn := map[string][]byte{}
+			if v, ok := attr["secret"].(string); ok {
+				conn["secret"] = []byte(v)
+			}
+			return conn, nil
+		}
+	})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+// Package admission contains admission webhooks that, unlike those of the
+// managed resource types themselves, need to read other resources.
+package admission
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aclv1alpha1 "github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	devicev1alpha1 "github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+	"github.com/supahlab/provider-tailscale/internal/hujson"
+	"github.com/supahlab/provider-tailscale/internal/providerconfig"
+)
+
+const (
+	errFmtUnexpectedType = "unexpected type %T"
+
+	warnFmtListACLs = "cannot check whether tags are declared in tagOwners: %s"
+	warnFmtUnowned  = "%s: tag %q is not declared in the tagOwners of any ACL using ProviderConfig %s"
+)
+
+// SetupDeviceTags registers a validating webhook for DeviceTags that, in
+// addition to the checks of the DeviceTags type itself, warns about tags that
+// are not declared in the tagOwners of the ACLs using the same
+// ProviderConfig. It must be called before the DeviceTags controller is set
+// up, whose own webhook is then not registered.
+func SetupDeviceTags(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&devicev1alpha1.DeviceTags{}).
+		WithValidator(&deviceTagsValidator{client: mgr.GetClient()}).
+		Complete()
+}
+
+type deviceTagsValidator struct {
+	client client.Reader
+}
+
+var _ admission.CustomValidator = &deviceTagsValidator{}
+
+func (v *deviceTagsValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	mg, ok := obj.(*devicev1alpha1.DeviceTags)
+	if !ok {
+		return nil, errors.Errorf(errFmtUnexpectedType, obj)
+	}
+	if _, err := mg.ValidateCreate(); err != nil {
+		return nil, err
+	}
+	return v.unownedTags(ctx, mg), nil
+}
+
+func (v *deviceTagsValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.ValidateCreate(ctx, newObj)
+}
+
+func (v *deviceTagsValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// unownedTags returns a warning for each tag of mg that is not declared in
+// the tagOwners of any ACL using the same ProviderConfig. Nothing is returned
+// if no such ACL exists, since the tailnet's policy is then not managed.
+func (v *deviceTagsValidator) unownedTags(ctx context.Context, mg *devicev1alpha1.DeviceTags) admission.Warnings {
+	l := &aclv1alpha1.ACLList{}
+	if err := v.client.List(ctx, l); err != nil {
+		return admission.Warnings{fmt.Sprintf(warnFmtListACLs, err)}
+	}
+
+	pc := providerconfig.Name(mg.GetProviderConfigReference())
+	owned := map[string]bool{}
+	managed := false
+	for i := range l.Items {
+		acl := &l.Items[i]
+		if providerconfig.Name(acl.GetProviderConfigReference()) != pc {
+			continue
+		}
+		policy := acl.Spec.ForProvider.ACL
+		if policy == nil {
+			policy = acl.Status.AtProvider.ACL
+		}
+		if policy == nil {
+			continue
+		}
+		p := struct {
+			TagOwners map[string]any `json:"tagOwners"`
+		}{}
+		// Invalid policies are rejected by the ACL webhook, and would only
+		// produce misleading warnings here.
+		if err := hujson.Unmarshal([]byte(*policy), &p); err != nil {
+			continue
+		}
+		managed = true
+		for t := range p.TagOwners {
+			owned[t] = true
+		}
+	}
+	if !managed {
+		return nil
+	}
+
+	spec := field.NewPath("spec")
+	warnings := unowned(spec.Child("forProvider", "tags"), mg.Spec.ForProvider.Tags, owned, pc)
+	return append(warnings, unowned(spec.Child("initProvider", "tags"), mg.Spec.InitProvider.Tags, owned, pc)...)
+}
+
+// unowned returns a warning for each element of tags that is not owned.
+func unowned(path *field.Path, tags []*string, owned map[string]bool, pc string) admission.Warnings {
+	var warnings admission.Warnings
+	for i, t := range tags {
+		if t != nil && !owned[*t] {
+			warnings = append(warnings, fmt.Sprintf(warnFmtUnowned, path.Index(i), *t, pc))
+		}
+	}
+	return warnings
+}
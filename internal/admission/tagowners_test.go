@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package admission
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	aclv1alpha1 "github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	devicev1alpha1 "github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+)
+
+const policy = `{
+  // Servers are tagged by the platform team.
+  "tagOwners": {
+    "tag:server": ["group:platform"],
+    "tag:ci":     ["autogroup:admin"],
+  },
+}`
+
+func newACL(name, pc string, policy *string) *aclv1alpha1.ACL {
+	acl := &aclv1alpha1.ACL{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	acl.Spec.ProviderConfigReference = &xpv1.Reference{Name: pc}
+	acl.Spec.ForProvider.ACL = policy
+	return acl
+}
+
+func newDeviceTags(pc string, tags ...string) *devicev1alpha1.DeviceTags {
+	mg := &devicev1alpha1.DeviceTags{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+	mg.Spec.ProviderConfigReference = &xpv1.Reference{Name: pc}
+	for _, t := range tags {
+		mg.Spec.ForProvider.Tags = append(mg.Spec.ForProvider.Tags, ptr.To(t))
+	}
+	return mg
+}
+
+func TestDeviceTagsValidator(t *testing.T) {
+	tags := field.NewPath("spec", "forProvider", "tags")
+
+	type args struct {
+		acls []client.Object
+		mg   *devicev1alpha1.DeviceTags
+	}
+	type want struct {
+		warnings admission.Warnings
+		err      error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Owned": {
+			reason: "Tags declared in the tagOwners of an ACL using the same ProviderConfig should be accepted without warnings.",
+			args: args{
+				acls: []client.Object{newACL("acl", "default", ptr.To(policy))},
+				mg:   newDeviceTags("default", "tag:server", "tag:ci"),
+			},
+		},
+		"Unowned": {
+			reason: "Tags not declared in the tagOwners of an ACL using the same ProviderConfig should be accepted with a warning.",
+			args: args{
+				acls: []client.Object{newACL("acl", "default", ptr.To(policy))},
+				mg:   newDeviceTags("default", "tag:server", "tag:db"),
+			},
+			want: want{
+				warnings: admission.Warnings{
+					`spec.forProvider.tags[1]: tag "tag:db" is not declared in the tagOwners of any ACL using ProviderConfig default`,
+				},
+			},
+		},
+		"OtherProviderConfig": {
+			reason: "The tagOwners of ACLs using another ProviderConfig should not count.",
+			args: args{
+				acls: []client.Object{
+					newACL("acl", "default", ptr.To(`{"tagOwners": {}}`)),
+					newACL("other", "other", ptr.To(policy)),
+				},
+				mg: newDeviceTags("default", "tag:server"),
+			},
+			want: want{
+				warnings: admission.Warnings{
+					`spec.forProvider.tags[0]: tag "tag:server" is not declared in the tagOwners of any ACL using ProviderConfig default`,
+				},
+			},
+		},
+		"NoACL": {
+			reason: "No warnings should be returned if no ACL using the same ProviderConfig is managed.",
+			args: args{
+				acls: []client.Object{newACL("other", "other", ptr.To(policy))},
+				mg:   newDeviceTags("default", "tag:db"),
+			},
+		},
+		"InvalidPolicy": {
+			reason: "ACLs whose policy cannot be parsed should be ignored.",
+			args: args{
+				acls: []client.Object{newACL("acl", "default", ptr.To(`{"tagOwners": `)), newACL("empty", "default", nil)},
+				mg:   newDeviceTags("default", "tag:db"),
+			},
+		},
+		"InvalidTag": {
+			reason: "Tags without the tag: prefix should still be rejected.",
+			args: args{
+				acls: []client.Object{newACL("acl", "default", ptr.To(policy))},
+				mg:   newDeviceTags("default", "server"),
+			},
+			want: want{
+				err: field.ErrorList{field.Invalid(tags.Index(0), "server", "must be a tag of the form tag:<name>, such as tag:server")}.ToAggregate(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			v := &deviceTagsValidator{client: clientfake.NewClientBuilder().WithScheme(s).WithObjects(tc.args.acls...).Build()}
+
+			warnings, err := v.ValidateCreate(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.warnings, warnings); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want warnings, +got warnings:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+const (
+	errDecodeResponse = "cannot decode Tailscale API response"
+	errNoAccessToken  = "Tailscale API responded without an access token"
+)
+
+// ValidateACL asks the Tailscale API whether it would accept the supplied
+// policy for the tailnet the supplied managed resource manages, using the
+// same configuration the resource's Terraform setup does. It returns why the
+// API rejects the policy, or "" if it accepts it. Errors satisfy IsUnverified
+// if the API could not answer.
+func ValidateACL(ctx context.Context, c client.Client, hc *http.Client, mg resource.Managed, policy string) (string, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return "", errors.New(errNoProviderConfig)
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return "", errors.Wrap(err, errGetProviderConfig)
+	}
+	cfg, err := managedConfiguration(ctx, c, mg, pc)
+	if err != nil {
+		return "", err
+	}
+	if err := validateConfiguration(cfg); err != nil {
+		return "", err
+	}
+	base := defaultBaseURL
+	if v, _ := cfg[keyBaseURL].(string); v != "" {
+		base = strings.TrimSuffix(v, "/")
+	}
+	ua, _ := cfg[keyUserAgent].(string)
+	if ua == "" {
+		ua = defaultUserAgent()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, base+"/api/v2/tailnet/"+url.PathEscape(tailnetOf(cfg))+"/acl/validate", strings.NewReader(policy))
+	if err != nil {
+		return "", errors.Wrap(err, errBuildRequest)
+	}
+	req.Header.Set("Content-Type", "application/hujson")
+	req.Header.Set("User-Agent", ua)
+	if id, _ := cfg[keyOAuthClientID].(string); id != "" {
+		token, err := accessToken(ctx, hc, base, cfg, ua)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		key, _ := cfg[keyAPIKey].(string)
+		req.SetBasicAuth(key, "")
+	}
+
+	resp, err := callAPI(hc, req, "tailnet/acl/validate")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck // The body is only read.
+	// A policy that cannot be parsed is rejected with 400 Bad Request, one
+	// whose tests fail with 200 OK. Both explain why in the body.
+	if resp.StatusCode != http.StatusBadRequest {
+		if err := statusError(resp); err != nil {
+			return "", err
+		}
+	}
+	v := validation{}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return "", errors.Wrap(err, errDecodeResponse)
+	}
+	return v.reason(), nil
+}
+
+// validation is the response of the Tailscale API to a policy validation.
+type validation struct {
+	Message string `json:"message"`
+	Data    []struct {
+		User   string   `json:"user"`
+		Errors []string `json:"errors"`
+	} `json:"data"`
+}
+
+// reason returns why the policy was rejected, or "" if it was accepted.
+func (v validation) reason() string {
+	msg := v.Message
+	for _, d := range v.Data {
+		for _, e := range d.Errors {
+			msg += fmt.Sprintf("; %s: %s", d.User, e)
+		}
+	}
+	return strings.TrimPrefix(msg, "; ")
+}
+
+// accessToken returns an access token of the OAuth client of the supplied
+// provider configuration, with its configured scopes.
+func accessToken(ctx context.Context, hc *http.Client, base string, cfg map[string]any, ua string) (string, error) {
+	req, err := tokenRequest(ctx, base, cfg, true)
+	if err != nil {
+		return "", errors.Wrap(err, errBuildRequest)
+	}
+	req.Header.Set("User-Agent", ua)
+	resp, err := callAPI(hc, req, "oauth/token")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck // The body is only read.
+	if err := statusError(resp); err != nil {
+		return "", err
+	}
+	t := struct {
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return "", errors.Wrap(err, errDecodeResponse)
+	}
+	if t.AccessToken == "" {
+		return "", errors.New(errNoAccessToken)
+	}
+	return t.AccessToken, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+func TestValidateACL(t *testing.T) {
+	const policy = `{"acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]}`
+
+	withOAuth := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+			ClientIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "id",
+			},
+			ClientSecretSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "secret",
+			},
+			Scopes: []string{"policy_file"},
+		}
+	}
+
+	type args struct {
+		code int
+		body string
+		mods []func(pc *v1beta1.ProviderConfig)
+	}
+	type want struct {
+		reason     string
+		err        error
+		unverified bool
+		auth       string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Accepted": {
+			reason: "A policy the API accepts should not be rejected.",
+			args: args{
+				code: http.StatusOK,
+				body: `{}`,
+			},
+			want: want{
+				auth: "Basic dHNrZXktYXBpLXRlc3Q6",
+			},
+		},
+		"TestsFailed": {
+			reason: "A policy whose tests fail should be rejected with the failures.",
+			args: args{
+				code: http.StatusOK,
+				body: `{"message":"test(s) failed","data":[{"user":"alice@example.com","errors":["address \"100.64.0.1:22\": want: Accept, got: Drop"]}]}`,
+			},
+			want: want{
+				reason: `test(s) failed; alice@example.com: address "100.64.0.1:22": want: Accept, got: Drop`,
+				auth:   "Basic dHNrZXktYXBpLXRlc3Q6",
+			},
+		},
+		"Unparsable": {
+			reason: "A policy the API cannot parse should be rejected with the parse error.",
+			args: args{
+				code: http.StatusBadRequest,
+				body: `{"message":"line 1, column 9: invalid character"}`,
+			},
+			want: want{
+				reason: "line 1, column 9: invalid character",
+				auth:   "Basic dHNrZXktYXBpLXRlc3Q6",
+			},
+		},
+		"Unauthorized": {
+			reason: "Credentials the API rejects should be reported as an error rather than as a rejected policy.",
+			args: args{
+				code: http.StatusUnauthorized,
+			},
+			want: want{
+				err:  errors.Errorf(errFmtAPIStatus, "401 Unauthorized"),
+				auth: "Basic dHNrZXktYXBpLXRlc3Q6",
+			},
+		},
+		"ServerError": {
+			reason: "A server error should be reported as unverified.",
+			args: args{
+				code: http.StatusBadGateway,
+			},
+			want: want{
+				err:        unverifiedError{errors.Errorf(errFmtAPIStatus, "502 Bad Gateway")},
+				unverified: true,
+				auth:       "Basic dHNrZXktYXBpLXRlc3Q6",
+			},
+		},
+		"OAuth": {
+			reason: "An OAuth client should validate the policy with an access token.",
+			args: args{
+				code: http.StatusOK,
+				body: `{}`,
+				mods: []func(pc *v1beta1.ProviderConfig){withOAuth},
+			},
+			want: want{
+				auth: "Bearer token",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var auth string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/oauth/token":
+					if got := r.FormValue("scope"); got != "policy_file" {
+						t.Errorf("\n%s\nValidateACL(...): want scope policy_file, got %q", tc.reason, got)
+					}
+					_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "token"})
+				case "/api/v2/tailnet/example.com/acl/validate":
+					auth = r.Header.Get("Authorization")
+					if b, _ := io.ReadAll(r.Body); string(b) != policy {
+						t.Errorf("\n%s\nValidateACL(...): want policy %s, got %s", tc.reason, policy, b)
+					}
+					w.WriteHeader(tc.args.code)
+					_, _ = w.Write([]byte(tc.args.body))
+				default:
+					t.Errorf("\n%s\nValidateACL(...): unexpected request to %s", tc.reason, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			pc := newProviderConfig("default", append(tc.args.mods, func(pc *v1beta1.ProviderConfig) {
+				pc.Spec.BaseURL = srv.URL
+				pc.Spec.Tailnet = "example.com"
+			})...)
+			c := newClient(t, pc,
+				newSecret("default", map[string]string{"credentials": `{"api_key":"tskey-api-test"}`}),
+				newSecret("oauth", map[string]string{"id": "id", "secret": "sec"}),
+			)
+
+			got, err := ValidateACL(context.Background(), c, srv.Client(), newManaged(nil), policy)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateACL(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if IsUnverified(err) != tc.want.unverified {
+				t.Errorf("\n%s\nValidateACL(...): want unverified %t, got error: %v", tc.reason, tc.want.unverified, err)
+			}
+			if diff := cmp.Diff(tc.want.reason, got); diff != "" {
+				t.Errorf("\n%s\nValidateACL(...): -want reason, +got reason:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.auth, auth); diff != "" {
+				t.Errorf("\n%s\nValidateACL(...): -want Authorization, +got Authorization:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/internal/providerconfig"
+)
+
+// LogConfigurationKeys logs the Terraform provider configuration keys the
+// provider recognizes and the credential sources configured on the default
+// ProviderConfig. Only key names and source kinds are logged, never values
+// or the names of the Secrets holding them.
+func LogConfigurationKeys(ctx context.Context, c client.Reader, log logging.Logger) error {
+	log.Info("Recognized provider configuration keys", "keys", configurationKeys, "annotation-keys", annotationKeys())
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: providerconfig.DefaultName}, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			log.Info("Default ProviderConfig not found")
+			return nil
+		}
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	log.Info("Default ProviderConfig credential sources", "source", pc.Spec.Credentials.Source, "additional-secrets", len(pc.Spec.Credentials.AdditionalSecretRefs), "oauth", pc.Spec.OAuth != nil)
+	return nil
+}
+
+// annotationKeys returns the provider configuration keys that may be set
+// through AnnotationPrefixConfig annotations.
+func annotationKeys() []string {
+	keys := make([]string, 0, len(annotationConfigKeys))
+	for _, k := range configurationKeys {
+		if annotationConfigKeys[k] {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
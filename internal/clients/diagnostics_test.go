@@ -0,0 +1,86 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/go-logr/logr/funcr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+func TestLogConfigurationKeys(t *testing.T) {
+	withEverything := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.Credentials.AdditionalSecretRefs = []xpv1.SecretKeySelector{{
+			SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "secret-additional"},
+			Key:             "key-additional",
+		}}
+		pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+			ClientIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "secret-oauth"},
+				Key:             "key-id",
+			},
+			ClientSecretSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "secret-oauth"},
+				Key:             "key-secret",
+			},
+		}
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{
+			AllowedSecrets: []xpv1.SecretReference{{Namespace: testNamespace, Name: "secret-override"}},
+		}
+	}
+	secrets := []client.Object{
+		newSecret("secret-default", map[string]string{"credentials": `{"api_key":"value-api-key","tailnet":"value-tailnet"}`}),
+		newSecret("secret-additional", map[string]string{"key-additional": `{"user_agent":"value-user-agent"}`}),
+		newSecret("secret-oauth", map[string]string{"key-id": "value-id", "key-secret": "value-secret"}),
+	}
+
+	cases := map[string]struct {
+		reason string
+		objs   []client.Object
+		want   []string
+	}{
+		"ProviderConfig": {
+			reason: "The credential sources of the default ProviderConfig should be logged without any Secret name or value.",
+			objs:   append([]client.Object{newProviderConfig("secret-default", withEverything)}, secrets...),
+			want:   []string{"Recognized provider configuration keys", "Default ProviderConfig credential sources"},
+		},
+		"NoProviderConfig": {
+			reason: "A missing default ProviderConfig should be logged without any Secret name or value.",
+			objs:   secrets,
+			want:   []string{"Recognized provider configuration keys", "Default ProviderConfig not found"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			log := logging.NewLogrLogger(funcr.New(func(prefix, args string) {
+				out.WriteString(prefix + args + "\n")
+			}, funcr.Options{}))
+
+			if err := LogConfigurationKeys(context.Background(), newClient(t, tc.objs...), log); err != nil {
+				t.Fatalf("\n%s\nLogConfigurationKeys(...): unexpected error: %v", tc.reason, err)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(out.String(), w) {
+					t.Errorf("\n%s\nLogConfigurationKeys(...): log does not contain %q:\n%s", tc.reason, w, out.String())
+				}
+			}
+			// Every Secret name, key and value of the fixtures starts with one
+			// of these prefixes, so none of them may appear in the log.
+			for _, s := range []string{"secret-", "key-", "value-"} {
+				if strings.Contains(out.String(), s) {
+					t.Errorf("\n%s\nLogConfigurationKeys(...): log contains %q:\n%s", tc.reason, s, out.String())
+				}
+			}
+		})
+	}
+}
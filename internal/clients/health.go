@@ -0,0 +1,204 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+const (
+	errBuildRequest = "cannot build Tailscale API request"
+	errCallAPI      = "cannot call the Tailscale API"
+	errFmtAPIStatus = "Tailscale API responded with %s"
+)
+
+// defaultBaseURL is the Tailscale API used when no base_url is configured.
+const defaultBaseURL = "https://api.tailscale.com"
+
+// defaultTailnet selects the tailnet that owns the credentials.
+const defaultTailnet = "-"
+
+// checkAttempts is how often CheckCredentials asks the Tailscale API before
+// reporting the credentials as unverified. defaultCheckBackoff is the wait
+// before the second attempt, which doubles before every further attempt.
+const (
+	checkAttempts       = 3
+	defaultCheckBackoff = time.Second
+)
+
+// unverifiedError wraps an error which means the Tailscale API could not
+// tell whether it accepts credentials, rather than that it rejected them.
+type unverifiedError struct {
+	error
+}
+
+// IsUnverified returns true if the supplied error was returned by
+// CheckCredentials or ValidateACL because the Tailscale API could not be
+// reached, responded with a server error or was rate limiting, so that the
+// credentials or policy are neither known to be accepted nor rejected.
+func IsUnverified(err error) bool {
+	var u unverifiedError
+	return errors.As(err, &u)
+}
+
+// CheckCredentials verifies that the Tailscale API accepts the credentials
+// of the supplied ProviderConfig. An OAuth client is checked by requesting
+// an access token, which does not depend on the scopes it was granted, and
+// an API key by listing the devices of the configured tailnet. A check the
+// API could not answer is retried with backoff, up to checkAttempts times in
+// all, while credentials the API rejects are reported at once. If the base
+// URL still cannot answer, the fallback base URLs are tried in order, except
+// for the Tailscale API if the API key is TestAPIKey.
+// CheckCredentials returns the base URL that answered, if any. The supplied
+// options are those of TerraformSetupBuilder, so that the check identifies
+// itself like the provider's other requests.
+func CheckCredentials(ctx context.Context, c client.Client, hc *http.Client, pc *v1beta1.ProviderConfig, opts ...SetupOption) (string, error) {
+	so := &setupOptions{}
+	for _, o := range opts {
+		o(so)
+	}
+	cfg, err := providerConfiguration(ctx, c, pc, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := validateConfiguration(cfg); err != nil {
+		return "", err
+	}
+	ua, _ := cfg[keyUserAgent].(string)
+	if ua == "" {
+		ua = defaultUserAgent()
+	}
+	ua = userAgent(so.userAgentPrefix, ua)
+
+	base := defaultBaseURL
+	if v, _ := cfg[keyBaseURL].(string); v != "" {
+		base = v
+	}
+	for _, u := range append([]string{base}, pc.Spec.FallbackBaseURLs...) {
+		if isTestAPIKey(cfg) && isTailscaleAPI(u) {
+			continue
+		}
+		if err = checkBaseURL(ctx, hc, u, cfg, ua, so.checkBackoff); !IsUnverified(err) {
+			return u, err
+		}
+	}
+	return "", err
+}
+
+// checkBaseURL asks the Tailscale API at the supplied base URL whether it
+// accepts the credentials of the supplied provider configuration, until it
+// answers or checkAttempts attempts were made.
+func checkBaseURL(ctx context.Context, hc *http.Client, base string, cfg map[string]any, ua string, backoff time.Duration) error {
+	if backoff == 0 {
+		backoff = defaultCheckBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		err := checkOnce(ctx, hc, strings.TrimSuffix(base, "/"), cfg, ua)
+		if !IsUnverified(err) || attempt == checkAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// checkOnce asks the Tailscale API at the supplied base URL once whether it
+// accepts the credentials of the supplied provider configuration.
+func checkOnce(ctx context.Context, hc *http.Client, base string, cfg map[string]any, ua string) error {
+	var req *http.Request
+	var endpoint string
+	var err error
+	if id, _ := cfg[keyOAuthClientID].(string); id != "" {
+		endpoint = "oauth/token"
+		req, err = tokenRequest(ctx, base, cfg, false)
+	} else {
+		endpoint = "tailnet/devices"
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, base+"/api/v2/tailnet/"+url.PathEscape(tailnetOf(cfg))+"/devices", nil)
+		if err == nil {
+			key, _ := cfg[keyAPIKey].(string)
+			req.SetBasicAuth(key, "")
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, errBuildRequest)
+	}
+	req.Header.Set("User-Agent", ua)
+
+	resp, err := callAPI(hc, req, endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Only the status is read.
+	return statusError(resp)
+}
+
+// tokenRequest returns a request for an access token of the OAuth client of
+// the supplied provider configuration, which only asks for its configured
+// scopes if scoped is true.
+func tokenRequest(ctx context.Context, base string, cfg map[string]any, scoped bool) (*http.Request, error) {
+	id, _ := cfg[keyOAuthClientID].(string)
+	secret, _ := cfg[keyOAuthClientSecret].(string)
+	form := url.Values{
+		"client_id":     {id},
+		"client_secret": {secret},
+		"grant_type":    {"client_credentials"},
+	}
+	if scopes, _ := cfg[keyOAuthScopes].([]string); scoped && len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, base+"/api/v2/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// tailnetOf returns the tailnet of the supplied provider configuration.
+func tailnetOf(cfg map[string]any) string {
+	if t, _ := cfg[keyTailnet].(string); t != "" {
+		return t
+	}
+	return defaultTailnet
+}
+
+// callAPI sends the supplied request to the Tailscale API and counts it in
+// APIRequests under the supplied endpoint. A request that cannot be sent is
+// unverified.
+func callAPI(hc *http.Client, req *http.Request, endpoint string) (*http.Response, error) {
+	resp, err := hc.Do(req)
+	if err != nil {
+		APIRequests.WithLabelValues(endpoint, "error").Inc()
+		return nil, unverifiedError{errors.Wrap(err, errCallAPI)}
+	}
+	APIRequests.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+	return resp, nil
+}
+
+// statusError returns an error if the supplied Tailscale API response is not
+// successful. Server errors and rate limiting are unverified.
+func statusError(resp *http.Response) error {
+	switch {
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests:
+		return unverifiedError{errors.Errorf(errFmtAPIStatus, resp.Status)}
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return errors.Errorf(errFmtAPIStatus, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+// apiRequest is what a fake Tailscale API received.
+type apiRequest struct {
+	Method    string
+	Path      string
+	User      string
+	Form      map[string][]string
+	UserAgent string
+}
+
+// newFakeAPI returns a fake Tailscale API that records every request it
+// receives into got and responds with the supplied status codes in turn,
+// repeating the last one once they are used up.
+func newFakeAPI(t *testing.T, got *[]apiRequest, codes ...int) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("cannot parse form: %v", err)
+		}
+		user, _, _ := r.BasicAuth()
+		req := apiRequest{Method: r.Method, Path: r.URL.Path, User: user, UserAgent: r.UserAgent()}
+		if len(r.PostForm) > 0 {
+			req.Form = r.PostForm
+		}
+		*got = append(*got, req)
+		w.WriteHeader(codes[min(len(*got), len(codes))-1])
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCheckCredentials(t *testing.T) {
+	withOAuth := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+			ClientIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "id",
+			},
+			ClientSecretSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "secret",
+			},
+		}
+	}
+
+	type args struct {
+		codes       []int
+		credentials map[string]string
+		mods        []func(pc *v1beta1.ProviderConfig)
+		opts        []SetupOption
+	}
+	type want struct {
+		reqs []apiRequest
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"APIKey": {
+			reason: "An API key should be checked by listing the devices of the tailnet owning it.",
+			args: args{
+				codes:       []int{http.StatusOK},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+			},
+		},
+		"Tailnet": {
+			reason: "An API key should be checked against the configured tailnet using the configured User-Agent.",
+			args: args{
+				codes:       []int{http.StatusOK},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test", keyTailnet: "example.com", keyUserAgent: "test/1.0"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/example.com/devices", User: "tskey-api-test", UserAgent: "test/1.0"}},
+			},
+		},
+		"OAuth": {
+			reason: "An OAuth client should be checked by requesting an access token.",
+			args: args{
+				codes: []int{http.StatusOK},
+				mods:  []func(pc *v1beta1.ProviderConfig){withOAuth},
+			},
+			want: want{
+				reqs: []apiRequest{{
+					Method: http.MethodPost,
+					Path:   "/api/v2/oauth/token",
+					Form: map[string][]string{
+						"client_id":     {"id"},
+						"client_secret": {"sec"},
+						"grant_type":    {"client_credentials"},
+					},
+					UserAgent: defaultUserAgent(),
+				}},
+			},
+		},
+		"UserAgentPrefix": {
+			reason: "The User-Agent prefix of the provider should be prepended to the configured User-Agent.",
+			args: args{
+				codes:       []int{http.StatusOK},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test", keyUserAgent: "test/1.0"},
+				opts:        []SetupOption{WithUserAgentPrefix("cluster/east")},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: "cluster/east test/1.0"}},
+			},
+		},
+		"Rejected": {
+			reason: "Credentials the API rejects should be reported with the response status.",
+			args: args{
+				codes:       []int{http.StatusUnauthorized},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+				err:  errors.Errorf(errFmtAPIStatus, "401 Unauthorized"),
+			},
+		},
+		"ServerError": {
+			reason: "A server error that persists over every attempt should be reported as unverified rather than as rejected credentials.",
+			args: args{
+				codes:       []int{http.StatusBadGateway},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+				err:  unverifiedError{errors.Errorf(errFmtAPIStatus, "502 Bad Gateway")},
+			},
+		},
+		"RateLimited": {
+			reason: "Rate limiting that persists over every attempt should be reported as unverified rather than as rejected credentials.",
+			args: args{
+				codes:       []int{http.StatusTooManyRequests},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+				err:  unverifiedError{errors.Errorf(errFmtAPIStatus, "429 Too Many Requests")},
+			},
+		},
+		"Recovered": {
+			reason: "A check the API could not answer should be retried until the API answers.",
+			args: args{
+				codes:       []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+			},
+		},
+		"RejectedAfterRetry": {
+			reason: "Credentials the API rejects once it answers should be reported without further attempts.",
+			args: args{
+				codes:       []int{http.StatusBadGateway, http.StatusUnauthorized, http.StatusOK},
+				credentials: map[string]string{keyAPIKey: "tskey-api-test"},
+			},
+			want: want{
+				reqs: []apiRequest{{Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}, {Method: http.MethodGet, Path: "/api/v2/tailnet/-/devices", User: "tskey-api-test", UserAgent: defaultUserAgent()}},
+				err:  errors.Errorf(errFmtAPIStatus, "401 Unauthorized"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []apiRequest
+			srv := newFakeAPI(t, &got, tc.args.codes...)
+
+			// Point the credentials at the fake API.
+			creds := map[string]string{keyBaseURL: srv.URL}
+			for k, v := range tc.args.credentials {
+				creds[k] = v
+			}
+			b, err := json.Marshal(creds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pc := newProviderConfig("default", tc.args.mods...)
+			c := newClient(t, pc,
+				newSecret("default", map[string]string{"credentials": string(b)}),
+				newSecret("oauth", map[string]string{"id": "id", "secret": "sec"}),
+			)
+
+			opts := append([]SetupOption{WithCheckBackoff(time.Millisecond)}, tc.args.opts...)
+			_, err = CheckCredentials(context.Background(), c, srv.Client(), pc, opts...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckCredentials(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reqs, got); diff != "" {
+				t.Errorf("\n%s\nCheckCredentials(...): -want requests, +got requests:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFakeAPI(t *testing.T) {
+	type want struct {
+		cfg  map[string]any
+		err  error
+		reqs int
+	}
+	cases := map[string]struct {
+		reason  string
+		baseURL func(srv *httptest.Server) string
+		want    want
+	}{
+		"FakeAPI": {
+			reason:  "The test API key should be passed through unchanged, and checked against, a fake API.",
+			baseURL: func(srv *httptest.Server) string { return srv.URL },
+			want:    want{reqs: 1},
+		},
+		"NoBaseURL": {
+			reason:  "The test API key should be refused without a base URL, so that it is never sent to the Tailscale API.",
+			baseURL: func(*httptest.Server) string { return "" },
+			want:    want{err: errors.New(errTestAPIKey)},
+		},
+		"TailscaleAPI": {
+			reason:  "The test API key should be refused for the Tailscale API.",
+			baseURL: func(*httptest.Server) string { return defaultBaseURL + "/" },
+			want:    want{err: errors.New(errTestAPIKey)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []apiRequest
+			srv := newFakeAPI(t, &got, http.StatusOK)
+			creds := map[string]string{keyAPIKey: TestAPIKey, keyTailnet: "example.com"}
+			if u := tc.baseURL(srv); u != "" {
+				creds[keyBaseURL] = u
+			}
+			data, err := json.Marshal(creds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pc := newProviderConfig("default")
+			c := newClient(t, pc, newSecret("default", map[string]string{"credentials": string(data)}))
+
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(nil))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err == nil {
+				want := map[string]any{
+					keyBaseURL:   srv.URL,
+					keyAPIKey:    TestAPIKey,
+					keyTailnet:   "example.com",
+					keyUserAgent: defaultUserAgent(),
+				}
+				if diff := cmp.Diff(want, map[string]any(ps.Configuration)); diff != "" {
+					t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+				}
+			}
+
+			_, err = CheckCredentials(context.Background(), c, srv.Client(), pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckCredentials(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if len(got) != tc.want.reqs {
+				t.Errorf("\n%s\nCheckCredentials(...): want %d requests to the fake API, got %d", tc.reason, tc.want.reqs, len(got))
+			}
+		})
+	}
+}
+
+func TestCheckCredentialsSkipsTailscaleAPI(t *testing.T) {
+	var got []apiRequest
+	srv := newFakeAPI(t, &got, http.StatusBadGateway)
+	pc := newProviderConfig("default", func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.BaseURL = srv.URL
+		pc.Spec.FallbackBaseURLs = []string{defaultBaseURL}
+	})
+	c := newClient(t, pc, newSecret("default", map[string]string{"credentials": `{"api_key":"` + TestAPIKey + `"}`}))
+
+	// The fallback would be unreachable from the test anyway, so a request to
+	// it would turn into an unverified error rather than a test failure.
+	hc := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Host != srv.Listener.Addr().String() {
+			t.Errorf("CheckCredentials(...): the test API key should never be sent to %s", r.URL.Host)
+		}
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	base, err := CheckCredentials(context.Background(), c, hc, pc, WithCheckBackoff(time.Millisecond))
+	if !IsUnverified(err) || base != "" {
+		t.Errorf("CheckCredentials(...): want an unverified check selecting no base URL, got %q and error: %v", base, err)
+	}
+}
+
+// roundTripperFunc is an http.RoundTripper implemented by a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestCheckCredentialsUnreachable(t *testing.T) {
+	var got []apiRequest
+	srv := newFakeAPI(t, &got, http.StatusOK)
+	url := srv.URL
+	srv.Close()
+
+	pc := newProviderConfig("default")
+	c := newClient(t, pc, newSecret("default", map[string]string{
+		"credentials": `{"base_url":"` + url + `","api_key":"tskey-api-test"}`,
+	}))
+	_, err := CheckCredentials(context.Background(), c, http.DefaultClient, pc, WithCheckBackoff(time.Millisecond))
+	if !IsUnverified(err) {
+		t.Errorf("CheckCredentials(...): an unreachable API should be reported as unverified, got: %v", err)
+	}
+}
+
+func TestCheckCredentialsFailover(t *testing.T) {
+	// base is the index of the base URL that should be selected, or -1.
+	type want struct {
+		base       int
+		unverified bool
+		reqs       []int
+	}
+	cases := map[string]struct {
+		reason string
+		codes  [][]int
+		want   want
+	}{
+		"PrimaryUp": {
+			reason: "The base URL should be used while it answers, without trying the fallbacks.",
+			codes:  [][]int{{http.StatusOK}, {http.StatusOK}},
+			want:   want{base: 0, reqs: []int{1, 0}},
+		},
+		"PrimaryRejects": {
+			reason: "A base URL that rejects the credentials answered, so the fallbacks should not be tried.",
+			codes:  [][]int{{http.StatusUnauthorized}, {http.StatusOK}},
+			want:   want{base: 0, reqs: []int{1, 0}},
+		},
+		"PrimaryDown": {
+			reason: "The first fallback that answers should be selected once the base URL failed every attempt.",
+			codes:  [][]int{{http.StatusBadGateway}, {http.StatusServiceUnavailable}, {http.StatusOK}, {http.StatusOK}},
+			want:   want{base: 2, reqs: []int{3, 3, 1, 0}},
+		},
+		"AllDown": {
+			reason: "The check should be unverified, and select no base URL, if none of them answers.",
+			codes:  [][]int{{http.StatusBadGateway}, {http.StatusTooManyRequests}},
+			want:   want{base: -1, unverified: true, reqs: []int{3, 3}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := make([][]apiRequest, len(tc.codes))
+			urls := make([]string, len(tc.codes))
+			for i, codes := range tc.codes {
+				urls[i] = newFakeAPI(t, &got[i], codes...).URL
+			}
+			pc := newProviderConfig("default", func(pc *v1beta1.ProviderConfig) {
+				pc.Spec.BaseURL = urls[0]
+				pc.Spec.FallbackBaseURLs = urls[1:]
+			})
+			c := newClient(t, pc, newSecret("default", map[string]string{"credentials": `{"api_key":"tskey-api-test"}`}))
+
+			base, err := CheckCredentials(context.Background(), c, http.DefaultClient, pc, WithCheckBackoff(time.Millisecond))
+			if IsUnverified(err) != tc.want.unverified {
+				t.Errorf("\n%s\nCheckCredentials(...): want unverified %t, got error: %v", tc.reason, tc.want.unverified, err)
+			}
+			want := ""
+			if tc.want.base >= 0 {
+				want = urls[tc.want.base]
+			}
+			if base != want {
+				t.Errorf("\n%s\nCheckCredentials(...): want base URL %q, got %q", tc.reason, want, base)
+			}
+			reqs := make([]int, len(got))
+			for i := range got {
+				reqs[i] = len(got[i])
+			}
+			if diff := cmp.Diff(tc.want.reqs, reqs); diff != "" {
+				t.Errorf("\n%s\nCheckCredentials(...): -want requests per base URL, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUnverified(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":        {err: nil, want: false},
+		"Rejected":   {err: errors.Errorf(errFmtAPIStatus, "401 Unauthorized"), want: false},
+		"Unverified": {err: unverifiedError{errors.New(errCallAPI)}, want: true},
+		"Wrapped":    {err: errors.Wrap(unverifiedError{errors.New(errCallAPI)}, "outer"), want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUnverified(tc.err); got != tc.want {
+				t.Errorf("IsUnverified(%v): want %t, got %t", tc.err, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	promNS       = "provider_tailscale"
+	promSysSetup = "setup"
+	promSysAPI   = "api"
+)
+
+// Stages of the Terraform setup that are timed separately.
+const (
+	stageGet       = "get"
+	stageTrack     = "track"
+	stageExtract   = "extract"
+	stageUnmarshal = "unmarshal"
+	stageValidate  = "validate"
+)
+
+// SetupTime is the histogram of how long each stage of building the
+// Terraform setup for a managed resource takes.
+var SetupTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: promNS,
+	Subsystem: promSysSetup,
+	Name:      "stage_duration_seconds",
+	Help:      "Measures in seconds how long a stage of the Terraform setup takes to complete",
+	Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+}, []string{"stage"})
+
+// APIRequests counts the requests the provider itself makes to the Tailscale
+// API, such as credentials checks, by endpoint and response status code.
+// Requests made by the Terraform provider are not included.
+var APIRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: promNS,
+	Subsystem: promSysAPI,
+	Name:      "requests_total",
+	Help:      "The number of requests made by the provider to the Tailscale API",
+}, []string{"endpoint", "code"})
+
+// timeStage starts a timer that records into SetupTime for the supplied
+// stage once ObserveDuration is called.
+func timeStage(stage string) *prometheus.Timer {
+	return prometheus.NewTimer(SetupTime.WithLabelValues(stage))
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSetupTime(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		objs   []client.Object
+		want   []string
+	}{
+		"Success": {
+			reason: "A successful setup should time getting the ProviderConfig, tracking its usage, extracting and unmarshalling the credentials, and validating them.",
+			objs: []client.Object{
+				newProviderConfig("default"),
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}),
+			},
+			want: []string{stageExtract, stageGet, stageTrack, stageUnmarshal, stageValidate},
+		},
+		"InvalidCredentials": {
+			reason: "A setup whose credentials are not usable should time validating them.",
+			objs: []client.Object{
+				newProviderConfig("default"),
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default","oauth_client_id":"id"}`}),
+			},
+			want: []string{stageExtract, stageGet, stageTrack, stageUnmarshal, stageValidate},
+		},
+		"NoProviderConfig": {
+			reason: "A setup that cannot get its ProviderConfig should only time getting it.",
+			want:   []string{stageGet},
+		},
+		"NoSecret": {
+			reason: "A setup that cannot get its credentials should neither time unmarshalling nor validating them.",
+			objs:   []client.Object{newProviderConfig("default")},
+			want:   []string{stageExtract, stageGet, stageTrack},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetupTime.Reset()
+			c := newClient(t, tc.objs...)
+			_, _ = TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(nil))
+			if diff := cmp.Diff(tc.want, observedStages(t)); diff != "" {
+				t.Errorf("\n%s\nSetupTime: -want stages, +got stages:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// observedStages returns the sorted stages SetupTime observed.
+func observedStages(t *testing.T) []string {
+	t.Helper()
+	r := prometheus.NewPedanticRegistry()
+	if err := r.Register(SetupTime); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Unregister(SetupTime)
+	mfs, err := r.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stages []string
+	for _, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "stage" {
+					stages = append(stages, l.GetValue())
+				}
+			}
+		}
+	}
+	sort.Strings(stages)
+	return stages
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"slices"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// defaultScopes are the OAuth scopes requested for the managed resources of
+// each API group when an OAuth client is configured without scopes. See
+// https://tailscale.com/kb/1215/oauth-clients#scopes.
+var defaultScopes = map[string][]string{
+	"acl.tailscale.com":       {"policy_file"},
+	"device.tailscale.com":    {"devices:core", "devices:routes"},
+	"dns.tailscale.com":       {"dns"},
+	"logstream.tailscale.com": {"logs:configuration"},
+	"oauth.tailscale.com":     {"oauth_keys"},
+	"tailnet.tailscale.com":   {"auth_keys", "account_settings", "feature_settings"},
+	"webhook.tailscale.com":   {"webhooks"},
+}
+
+// oauthDefaultScopes sets the default scopes of the API group of the
+// supplied managed resource in cfg if it configures an OAuth client without
+// scopes. Groups without defaults, and kinds the supplied scheme does not
+// know, leave the scopes unset, which requests all scopes of the client.
+func oauthDefaultScopes(s *runtime.Scheme, mg resource.Managed, cfg map[string]any) {
+	if id, _ := cfg[keyOAuthClientID].(string); id == "" {
+		return
+	}
+	if _, ok := cfg[keyOAuthScopes]; ok {
+		return
+	}
+	gvk, err := apiutil.GVKForObject(mg, s)
+	if err != nil {
+		return
+	}
+	if scopes, ok := defaultScopes[gvk.Group]; ok {
+		cfg[keyOAuthScopes] = slices.Clone(scopes)
+	}
+}
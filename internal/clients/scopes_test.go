@@ -0,0 +1,137 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	aclv1alpha1 "github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	devicev1alpha1 "github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+	dnsv1alpha1 "github.com/supahlab/provider-tailscale/apis/dns/v1alpha1"
+	logstreamv1alpha1 "github.com/supahlab/provider-tailscale/apis/logstream/v1alpha1"
+	oauthv1alpha1 "github.com/supahlab/provider-tailscale/apis/oauth/v1alpha1"
+	posturev1alpha1 "github.com/supahlab/provider-tailscale/apis/posture/v1alpha1"
+	tailnetv1alpha1 "github.com/supahlab/provider-tailscale/apis/tailnet/v1alpha1"
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	webhookv1alpha1 "github.com/supahlab/provider-tailscale/apis/webhook/v1alpha1"
+)
+
+func TestDefaultScopes(t *testing.T) {
+	withOAuth := func(scopes ...string) func(pc *v1beta1.ProviderConfig) {
+		return func(pc *v1beta1.ProviderConfig) {
+			pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+				ClientIDSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+					Key:             "id",
+				},
+				ClientSecretSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+					Key:             "secret",
+				},
+				Scopes: scopes,
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     *v1beta1.ProviderConfig
+		mg     resource.Managed
+		want   any
+	}{
+		"ACL": {
+			reason: "An ACL should request the policy file scope.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &aclv1alpha1.ACL{},
+			want:   []string{"policy_file"},
+		},
+		"Device": {
+			reason: "Device resources should request the device scopes.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &devicev1alpha1.DeviceSubnetRoutes{},
+			want:   []string{"devices:core", "devices:routes"},
+		},
+		"DNS": {
+			reason: "DNS resources should request the DNS scope.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &dnsv1alpha1.DNSNameservers{},
+			want:   []string{"dns"},
+		},
+		"LogStream": {
+			reason: "Log streaming resources should request the log configuration scope.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &logstreamv1alpha1.LogstreamConfiguration{},
+			want:   []string{"logs:configuration"},
+		},
+		"OAuth": {
+			reason: "An OAuth client should request the OAuth keys scope.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &oauthv1alpha1.OAuthClient{},
+			want:   []string{"oauth_keys"},
+		},
+		"Tailnet": {
+			reason: "Tailnet resources should request the auth key and settings scopes.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &tailnetv1alpha1.TailnetKey{},
+			want:   []string{"auth_keys", "account_settings", "feature_settings"},
+		},
+		"Webhook": {
+			reason: "A webhook should request the webhooks scope.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &webhookv1alpha1.Webhook{},
+			want:   []string{"webhooks"},
+		},
+		"NoDefaults": {
+			reason: "A group without default scopes should request all scopes of the OAuth client.",
+			pc:     newProviderConfig("default", withOAuth()),
+			mg:     &posturev1alpha1.PostureIntegration{},
+		},
+		"Configured": {
+			reason: "Configured scopes should take precedence over the defaults of the group.",
+			pc:     newProviderConfig("default", withOAuth("dns:read")),
+			mg:     &dnsv1alpha1.DNSNameservers{},
+			want:   []string{"dns:read"},
+		},
+		"APIKey": {
+			reason: "No scopes should be requested for an API key.",
+			pc:     newProviderConfig("default"),
+			mg:     &dnsv1alpha1.DNSNameservers{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(tc.pc,
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}),
+				newSecret("oauth", map[string]string{"id": "id", "secret": "sec"}),
+			).Build()
+			tc.mg.SetUID("11111111-2222-3333-4444-555555555555")
+			tc.mg.SetProviderConfigReference(&xpv1.Reference{Name: testPCName})
+
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, tc.mg)
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, ps.Configuration[keyOAuthScopes]); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want scopes, +got scopes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
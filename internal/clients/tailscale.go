@@ -7,15 +7,23 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/upjet/pkg/terraform"
 
 	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/internal/version"
 )
 
 const (
@@ -25,21 +33,133 @@ const (
 	errTrackUsage           = "cannot track ProviderConfig usage"
 	errExtractCredentials   = "cannot extract credentials"
 	errUnmarshalCredentials = "cannot unmarshal tailscale credentials as JSON"
+	errNotJSONObject        = "credentials are not a JSON object"
+
+	errFmtAnnotationConfigKey   = "provider configuration key %q cannot be set through annotations"
+	errFmtOverrideNamespace     = "annotation %s requires %s to be set"
+	errFmtOverrideNotAllowed    = "ProviderConfig %s does not allow reading credentials from Secret %s/%s"
+	errFmtAdditionalSecret      = "cannot merge credentials from Secret %s/%s"
+	errNoEnvironmentCredentials = "none of the Tailscale credential environment variables is set"
+	errGetOAuthClientID         = "cannot get OAuth client ID"
+	errGetOAuthClientSecret     = "cannot get OAuth client secret"
+	errFmtCredentialsKeyType    = "credentials key %q must be a %s"
+	errFmtConflictingKeys       = "provider configuration keys %q and %q cannot both be set"
+	errFmtMissingKey            = "provider configuration key %q requires %q to be set"
+	errTestAPIKey               = "the test API key requires a base_url other than the Tailscale API"
 )
 
+// AnnotationPrefixConfig is the prefix of managed resource annotations that
+// set additional Terraform provider configuration keys for that resource,
+// e.g. config.tailscale.crossplane.io/tailnet to manage the resource in a
+// tailnet other than the ProviderConfig's.
+const AnnotationPrefixConfig = "config.tailscale.crossplane.io/"
+
+// Annotations that make a managed resource read its credentials from the
+// referenced Secret rather than from its ProviderConfig, provided that the
+// ProviderConfig allows the Secret in its spec.resourceCredentials. The
+// ProviderConfig is still used, and its usage tracked, for everything else.
+const (
+	AnnotationKeyCredentialsSecretName      = "tailscale.crossplane.io/credentials-secret-name"
+	AnnotationKeyCredentialsSecretNamespace = "tailscale.crossplane.io/credentials-secret-namespace"
+	AnnotationKeyCredentialsSecretKey       = "tailscale.crossplane.io/credentials-secret-key"
+)
+
+// TestAPIKey is the API key of credentials meant for a fake Tailscale API,
+// such as an httptest server in an integration test. It is refused unless a
+// base_url other than the Tailscale API is configured, so that placeholder
+// credentials are never sent to Tailscale.
+const TestAPIKey = "tskey-api-test"
+
+// defaultCredentialsSecretKey is the key of the overriding credentials Secret
+// that is read when AnnotationKeyCredentialsSecretKey is not set.
+const defaultCredentialsSecretKey = "credentials"
+
 const (
-	keyBaseURL = "base_url" // (String) The base URL of the Tailscale API. Defaults to https://api.tailscale.com. Can be set via the TAILSCALE_BASE_URL environment variable.
-	keyAPIKey = "api_key" // (String, Sensitive) The API key to use for authenticating requests to the API. Can be set via the TAILSCALE_API_KEY environment variable. Conflicts with 'oauth_client_id' and 'oauth_client_secret'.
-	keyOAuthClientID = "oauth_client_id" // (String) The OAuth application's ID when using OAuth client credentials. Can be set via the TAILSCALE_OAUTH_CLIENT_ID environment variable. Both 'oauth_client_id' and 'oauth_client_secret' must be set. Conflicts with 'api_key'.
+	keyBaseURL           = "base_url"            // (String) The base URL of the Tailscale API. Defaults to https://api.tailscale.com. Can be set via the TAILSCALE_BASE_URL environment variable.
+	keyAPIKey            = "api_key"             // (String, Sensitive) The API key to use for authenticating requests to the API. Can be set via the TAILSCALE_API_KEY environment variable. Conflicts with 'oauth_client_id' and 'oauth_client_secret'.
+	keyOAuthClientID     = "oauth_client_id"     // (String) The OAuth application's ID when using OAuth client credentials. Can be set via the TAILSCALE_OAUTH_CLIENT_ID environment variable. Both 'oauth_client_id' and 'oauth_client_secret' must be set. Conflicts with 'api_key'.
 	keyOAuthClientSecret = "oauth_client_secret" // (String, Sensitive) The OAuth application's secret when using OAuth client credentials. Can be set via the TAILSCALE_OAUTH_CLIENT_SECRET environment variable. Both 'oauth_client_id' and 'oauth_client_secret' must be set. Conflicts with 'api_key'.
-	keyOAuthScopes = "scopes" // (List of String) The OAuth 2.0 scopes to request for the access token generated using the supplied OAuth client credentials. See https://tailscale.com/kb/1215/oauth-clients/#scopes for available scopes. Only valid when both 'oauth_client_id' and 'oauth_client_secret' are set.
-	keyTailnet = "tailnet" // (String) The organization name of the Tailnet in which to perform actions. Can be set via the TAILSCALE_TAILNET environment variable. Default is the tailnet that owns API credentials passed to the provider.
-	keyUserAgent = "user_agent" // user_agent (String) User-Agent header for API requests.
+	keyOAuthScopes       = "scopes"              // (List of String) The OAuth 2.0 scopes to request for the access token generated using the supplied OAuth client credentials. See https://tailscale.com/kb/1215/oauth-clients/#scopes for available scopes. Only valid when both 'oauth_client_id' and 'oauth_client_secret' are set.
+	keyTailnet           = "tailnet"             // (String) The organization name of the Tailnet in which to perform actions. Can be set via the TAILSCALE_TAILNET environment variable. Default is the tailnet that owns API credentials passed to the provider.
+	keyUserAgent         = "user_agent"          // user_agent (String) User-Agent header for API requests.
 )
 
+// configurationKeys are the Terraform provider configuration keys read from
+// the credentials.
+var configurationKeys = []string{
+	keyAPIKey,
+	keyBaseURL,
+	keyOAuthClientID,
+	keyOAuthClientSecret,
+	keyOAuthScopes,
+	keyTailnet,
+	keyUserAgent,
+}
+
+// environmentVariables are the environment variables of the provider read
+// for the Environment credentials source when it names no variable, keyed
+// by the configuration key they set. There is one for every configuration
+// key; those the Terraform provider itself reads keep their names.
+var environmentVariables = map[string]string{
+	keyAPIKey:            "TAILSCALE_API_KEY",
+	keyBaseURL:           "TAILSCALE_BASE_URL",
+	keyOAuthClientID:     "TAILSCALE_OAUTH_CLIENT_ID",
+	keyOAuthClientSecret: "TAILSCALE_OAUTH_CLIENT_SECRET",
+	keyOAuthScopes:       "TAILSCALE_OAUTH_SCOPES",
+	keyTailnet:           "TAILSCALE_TAILNET",
+	keyUserAgent:         "TAILSCALE_USER_AGENT",
+}
+
+// annotationConfigKeys is the set of provider configuration keys that may be
+// set through AnnotationPrefixConfig annotations. Keys which carry
+// credentials or select the API endpoint are deliberately left out.
+var annotationConfigKeys = map[string]bool{
+	keyTailnet:   true,
+	keyUserAgent: true,
+}
+
+// SetupOption configures the terraform.SetupFn returned by
+// TerraformSetupBuilder.
+type SetupOption func(o *setupOptions)
+
+type setupOptions struct {
+	userAgentPrefix string
+	scheduler       terraform.ProviderScheduler
+	checkBackoff    time.Duration
+}
+
+// WithUserAgentPrefix prepends the supplied prefix to the User-Agent of every
+// Tailscale API request, e.g. to identify the cluster the provider runs in.
+func WithUserAgentPrefix(prefix string) SetupOption {
+	return func(o *setupOptions) {
+		o.userAgentPrefix = prefix
+	}
+}
+
+// WithProviderScheduler makes Terraform use Terraform provider processes
+// started by the supplied scheduler, e.g. one shared between many managed
+// resources, instead of starting one for every Terraform invocation.
+func WithProviderScheduler(s terraform.ProviderScheduler) SetupOption {
+	return func(o *setupOptions) {
+		o.scheduler = s
+	}
+}
+
+// WithCheckBackoff makes CheckCredentials wait the supplied duration instead
+// of defaultCheckBackoff before asking the Tailscale API again.
+func WithCheckBackoff(d time.Duration) SetupOption {
+	return func(o *setupOptions) {
+		o.checkBackoff = d
+	}
+}
+
 // TerraformSetupBuilder builds Terraform a terraform.SetupFn function which
 // returns Terraform provider setup configuration
-func TerraformSetupBuilder(version, providerSource, providerVersion string) terraform.SetupFn {
+func TerraformSetupBuilder(version, providerSource, providerVersion string, opts ...SetupOption) terraform.SetupFn {
+	so := &setupOptions{}
+	for _, o := range opts {
+		o(so)
+	}
 	return func(ctx context.Context, client client.Client, mg resource.Managed) (terraform.Setup, error) {
 		ps := terraform.Setup{
 			Version: version,
@@ -47,6 +167,7 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 				Source:  providerSource,
 				Version: providerVersion,
 			},
+			Scheduler: so.scheduler,
 		}
 
 		configRef := mg.GetProviderConfigReference()
@@ -54,46 +175,367 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 			return ps, errors.New(errNoProviderConfig)
 		}
 		pc := &v1beta1.ProviderConfig{}
-		if err := client.Get(ctx, types.NamespacedName{Name: configRef.Name}, pc); err != nil {
+		timer := timeStage(stageGet)
+		err := client.Get(ctx, types.NamespacedName{Name: configRef.Name}, pc)
+		timer.ObserveDuration()
+		if err != nil {
 			return ps, errors.Wrap(err, errGetProviderConfig)
 		}
 
 		t := resource.NewProviderConfigUsageTracker(client, &v1beta1.ProviderConfigUsage{})
-		if err := t.Track(ctx, mg); err != nil {
+		timer = timeStage(stageTrack)
+		err = t.Track(ctx, mg)
+		timer.ObserveDuration()
+		if err != nil {
 			return ps, errors.Wrap(err, errTrackUsage)
 		}
 
-		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, client, pc.Spec.Credentials.CommonCredentialSelectors)
+		if ps.Configuration, err = managedConfiguration(ctx, client, mg, pc); err != nil {
+			return ps, err
+		}
+		timer = timeStage(stageValidate)
+		err = validateConfiguration(ps.Configuration)
+		timer.ObserveDuration()
 		if err != nil {
-			return ps, errors.Wrap(err, errExtractCredentials)
+			return ps, err
 		}
-		creds := map[string]string{}
-		if err := json.Unmarshal(data, &creds); err != nil {
-			return ps, errors.Wrap(err, errUnmarshalCredentials)
+		ua, _ := ps.Configuration[keyUserAgent].(string)
+		if ua == "" {
+			ua = defaultUserAgent()
 		}
-		
-		ps.Configuration = map[string]any{}
-		if v, ok := creds[keyAPIKey]; ok {
-		  ps.Configuration[keyAPIKey] = v
+		ps.Configuration[keyUserAgent] = userAgent(so.userAgentPrefix, ua)
+		return ps, nil
+	}
+}
+
+// managedConfiguration returns the Terraform provider configuration of the
+// supplied managed resource, which uses the supplied ProviderConfig.
+func managedConfiguration(ctx context.Context, client client.Client, mg resource.Managed, pc *v1beta1.ProviderConfig) (map[string]any, error) {
+	ref, err := credentialsOverride(mg, pc)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := providerConfiguration(ctx, client, pc, ref)
+	if err != nil {
+		return nil, err
+	}
+	// Use the fallback base URL that answered the last credentials check
+	// instead of the base URL.
+	if u := pc.Status.BaseURL; u != "" && slices.Contains(pc.Spec.FallbackBaseURLs, u) {
+		cfg[keyBaseURL] = u
+	}
+	if err := configFromAnnotations(mg, cfg); err != nil {
+		return nil, err
+	}
+	oauthDefaultScopes(client.Scheme(), mg, cfg)
+	return cfg, nil
+}
+
+// providerConfiguration returns the Terraform provider configuration of the
+// supplied ProviderConfig. It reads the credentials source, then merges the
+// additional Secrets in order, then the base URL and tailnet of the spec,
+// then the OAuth client, each taking precedence over what came before it.
+// If override is set, the credentials are read from it alone and the
+// credentials configured on the ProviderConfig, including its additional
+// Secrets and OAuth client, are ignored.
+func providerConfiguration(ctx context.Context, client client.Client, pc *v1beta1.ProviderConfig, override *xpv1.SecretKeySelector) (map[string]any, error) {
+	src, sel := pc.Spec.Credentials.Source, pc.Spec.Credentials.CommonCredentialSelectors
+	additional := pc.Spec.Credentials.AdditionalSecretRefs
+	if override != nil {
+		src, sel = xpv1.CredentialsSourceSecret, xpv1.CommonCredentialSelectors{SecretRef: override}
+		additional = nil
+	}
+	creds := map[string]any{}
+	if src != xpv1.CredentialsSourceNone {
+		var err error
+		if creds, err = extractCredentials(ctx, client, src, sel); err != nil {
+			return nil, err
 		}
-		if v, ok := creds[keyBaseURL]; ok {
-		  ps.Configuration[keyBaseURL] = v
+	}
+	for _, ref := range additional {
+		if err := mergeSecretCredentials(ctx, client, ref, creds); err != nil {
+			return nil, err
 		}
-		if v, ok := creds[keyOAuthClientID]; ok {
-		  ps.Configuration[keyOAuthClientID] = v
+	}
+
+	cfg := map[string]any{}
+	for _, k := range configurationKeys {
+		if v, ok := creds[k]; ok {
+			cfg[k] = v
 		}
-		if v, ok := creds[keyOAuthClientSecret]; ok {
-		  ps.Configuration[keyOAuthClientSecret] = v
+	}
+	if pc.Spec.BaseURL != "" {
+		cfg[keyBaseURL] = pc.Spec.BaseURL
+	}
+	if pc.Spec.Tailnet != "" {
+		cfg[keyTailnet] = pc.Spec.Tailnet
+	}
+	if pc.Spec.OAuth != nil && override == nil {
+		if err := oauthConfig(ctx, client, pc.Spec.OAuth, cfg); err != nil {
+			return nil, err
 		}
-		if v, ok := creds[keyOAuthScopes]; ok {
-		  ps.Configuration[keyOAuthScopes] = v
+	}
+	return cfg, nil
+}
+
+// validateConfiguration returns an error if the credentials of the supplied
+// Terraform provider configuration are not usable: an API key and an OAuth
+// client are mutually exclusive, an OAuth client needs both its ID and its
+// secret, and TestAPIKey needs a base_url other than the Tailscale API.
+func validateConfiguration(cfg map[string]any) error {
+	if base, _ := cfg[keyBaseURL].(string); isTestAPIKey(cfg) && isTailscaleAPI(base) {
+		return errors.New(errTestAPIKey)
+	}
+	_, key := cfg[keyAPIKey]
+	_, id := cfg[keyOAuthClientID]
+	_, secret := cfg[keyOAuthClientSecret]
+	switch {
+	case key && id:
+		return errors.Errorf(errFmtConflictingKeys, keyAPIKey, keyOAuthClientID)
+	case key && secret:
+		return errors.Errorf(errFmtConflictingKeys, keyAPIKey, keyOAuthClientSecret)
+	case id && !secret:
+		return errors.Errorf(errFmtMissingKey, keyOAuthClientID, keyOAuthClientSecret)
+	case secret && !id:
+		return errors.Errorf(errFmtMissingKey, keyOAuthClientSecret, keyOAuthClientID)
+	}
+	return nil
+}
+
+// isTestAPIKey returns true if the API key of the supplied Terraform provider
+// configuration is TestAPIKey.
+func isTestAPIKey(cfg map[string]any) bool {
+	key, _ := cfg[keyAPIKey].(string)
+	return key == TestAPIKey
+}
+
+// isTailscaleAPI returns true if the supplied base URL, which defaults to
+// defaultBaseURL if empty, is the Tailscale API.
+func isTailscaleAPI(base string) bool {
+	return base == "" || strings.EqualFold(strings.TrimSuffix(base, "/"), defaultBaseURL)
+}
+
+// configFromAnnotations copies the provider configuration keys set through
+// AnnotationPrefixConfig annotations of the supplied managed resource into
+// cfg. Keys outside annotationConfigKeys are rejected.
+func configFromAnnotations(mg resource.Managed, cfg map[string]any) error {
+	for k, v := range mg.GetAnnotations() {
+		key, ok := strings.CutPrefix(k, AnnotationPrefixConfig)
+		if !ok {
+			continue
 		}
-		if v, ok := creds[keyTailnet]; ok {
-		  ps.Configuration[keyTailnet] = v
+		if !annotationConfigKeys[key] {
+			return errors.Errorf(errFmtAnnotationConfigKey, key)
 		}
-		if v, ok := creds[keyUserAgent]; ok {
-		  ps.Configuration[keyUserAgent] = v
+		cfg[key] = v
+	}
+	return nil
+}
+
+// mergeSecretCredentials merges the credentials stored in the Secret
+// referenced by ref into creds, overwriting keys that are already set.
+func mergeSecretCredentials(ctx context.Context, client client.Client, ref xpv1.SecretKeySelector, creds map[string]any) error {
+	m, err := extractCredentials(ctx, client, xpv1.CredentialsSourceSecret, xpv1.CommonCredentialSelectors{SecretRef: &ref})
+	if err != nil {
+		return errors.Wrapf(err, errFmtAdditionalSecret, ref.Namespace, ref.Name)
+	}
+	for k, v := range m {
+		creds[k] = v
+	}
+	return nil
+}
+
+// extractCredentials extracts the credentials from the supplied source. They
+// are expected to be a JSON object, except that a Secret may instead store
+// one key per provider configuration key, e.g. api_key and tailnet, in which
+// case the selected key may be any of them, and that the Environment source
+// reads environmentVariables unless it names a variable.
+func extractCredentials(ctx context.Context, client client.Client, src xpv1.CredentialsSource, sel xpv1.CommonCredentialSelectors) (map[string]any, error) {
+	if src == xpv1.CredentialsSourceEnvironment && sel.Env == nil {
+		return environmentCredentials()
+	}
+	timer := timeStage(stageExtract)
+	data, err := resource.CommonCredentialExtractor(ctx, src, client, sel)
+	timer.ObserveDuration()
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractCredentials)
+	}
+	var doc any
+	timer = timeStage(stageUnmarshal)
+	err = json.Unmarshal(data, &doc)
+	timer.ObserveDuration()
+	if creds, ok := doc.(map[string]any); ok && err == nil {
+		return creds, checkCredentialTypes(creds)
+	}
+	if err == nil {
+		err = errors.New(errNotJSONObject)
+	}
+	if src != xpv1.CredentialsSourceSecret || sel.SecretRef == nil {
+		return nil, errors.Wrap(err, errUnmarshalCredentials)
+	}
+	s := &corev1.Secret{}
+	timer = timeStage(stageExtract)
+	gerr := client.Get(ctx, types.NamespacedName{Namespace: sel.SecretRef.Namespace, Name: sel.SecretRef.Name}, s)
+	timer.ObserveDuration()
+	if gerr != nil {
+		return nil, errors.Wrap(gerr, errExtractCredentials)
+	}
+	creds := map[string]any{}
+	for _, k := range configurationKeys {
+		v, ok := s.Data[k]
+		switch {
+		case !ok:
+		case k == keyOAuthScopes:
+			creds[k] = splitScopes(string(v))
+		default:
+			creds[k] = string(v)
 		}
-		return ps, nil
 	}
+	if len(creds) == 0 {
+		// Neither a JSON object nor structured keys, so report why the
+		// selected key could not be read as the former.
+		return nil, errors.Wrap(err, errUnmarshalCredentials)
+	}
+	return creds, nil
+}
+
+// checkCredentialTypes returns an error if a provider configuration key of
+// the supplied JSON credentials is not a string, or in the case of scopes
+// a list of strings, which it converts to []string.
+func checkCredentialTypes(creds map[string]any) error {
+	for _, k := range configurationKeys {
+		v, ok := creds[k]
+		if !ok {
+			continue
+		}
+		if k != keyOAuthScopes {
+			if _, ok := v.(string); !ok {
+				return errors.Errorf(errFmtCredentialsKeyType, k, "string")
+			}
+			continue
+		}
+		l, ok := v.([]any)
+		scopes := make([]string, len(l))
+		for i := range l {
+			if scopes[i], ok = l[i].(string); !ok {
+				break
+			}
+		}
+		if !ok {
+			return errors.Errorf(errFmtCredentialsKeyType, k, "list of strings")
+		}
+		creds[k] = scopes
+	}
+	return nil
+}
+
+// splitScopes splits the supplied comma or whitespace separated OAuth scopes.
+func splitScopes(v string) []string {
+	return strings.FieldsFunc(v, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+}
+
+// environmentCredentials reads the credentials from environmentVariables,
+// ignoring those that are empty. Scopes are separated like those of a
+// Secret storing one key per configuration key.
+func environmentCredentials() (map[string]any, error) {
+	creds := map[string]any{}
+	for k, env := range environmentVariables {
+		v := os.Getenv(env)
+		switch {
+		case v == "":
+		case k == keyOAuthScopes:
+			creds[k] = splitScopes(v)
+		default:
+			creds[k] = v
+		}
+	}
+	if len(creds) == 0 {
+		return nil, errors.New(errNoEnvironmentCredentials)
+	}
+	return creds, nil
+}
+
+// oauthConfig sets the OAuth client configured by o in cfg, replacing any API
+// key or OAuth client read from the credentials.
+func oauthConfig(ctx context.Context, client client.Client, o *v1beta1.ProviderOAuth, cfg map[string]any) error {
+	timer := timeStage(stageExtract)
+	id, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, client, xpv1.CommonCredentialSelectors{SecretRef: &o.ClientIDSecretRef})
+	timer.ObserveDuration()
+	if err != nil {
+		return errors.Wrap(err, errGetOAuthClientID)
+	}
+	timer = timeStage(stageExtract)
+	secret, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, client, xpv1.CommonCredentialSelectors{SecretRef: &o.ClientSecretSecretRef})
+	timer.ObserveDuration()
+	if err != nil {
+		return errors.Wrap(err, errGetOAuthClientSecret)
+	}
+	delete(cfg, keyAPIKey)
+	cfg[keyOAuthClientID] = string(id)
+	cfg[keyOAuthClientSecret] = string(secret)
+	if len(o.Scopes) > 0 {
+		cfg[keyOAuthScopes] = o.Scopes
+	}
+	return nil
+}
+
+// credentialsOverride returns the credentials Secret referenced by the
+// AnnotationKeyCredentialsSecretName annotation of the supplied managed
+// resource, or nil if the resource does not override its credentials. It
+// returns an error if the supplied ProviderConfig does not allow the Secret.
+func credentialsOverride(mg resource.Managed, pc *v1beta1.ProviderConfig) (*xpv1.SecretKeySelector, error) {
+	a := mg.GetAnnotations()
+	name := a[AnnotationKeyCredentialsSecretName]
+	if name == "" {
+		return nil, nil
+	}
+	ns := a[AnnotationKeyCredentialsSecretNamespace]
+	if ns == "" {
+		return nil, errors.Errorf(errFmtOverrideNamespace, AnnotationKeyCredentialsSecretName, AnnotationKeyCredentialsSecretNamespace)
+	}
+	if !overrideAllowed(pc.Spec.ResourceCredentials, ns, name) {
+		return nil, errors.Errorf(errFmtOverrideNotAllowed, pc.GetName(), ns, name)
+	}
+	key := a[AnnotationKeyCredentialsSecretKey]
+	if key == "" {
+		key = defaultCredentialsSecretKey
+	}
+	return &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: name, Namespace: ns},
+		Key:             key,
+	}, nil
+}
+
+// overrideAllowed returns true if rc allows managed resources to read their
+// credentials from the Secret with the supplied namespace and name.
+func overrideAllowed(rc *v1beta1.ResourceCredentials, ns, name string) bool {
+	if rc == nil {
+		return false
+	}
+	for _, allowed := range rc.AllowedNamespaces {
+		if allowed == ns {
+			return true
+		}
+	}
+	for _, s := range rc.AllowedSecrets {
+		if s.Namespace == ns && s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultUserAgent is the User-Agent of Tailscale API requests when none is
+// configured, identifying this provider and its version.
+func defaultUserAgent() string {
+	return "crossplane-provider-tailscale/" + version.Version
+}
+
+// userAgent composes the User-Agent from the supplied prefix, which may be
+// empty, and User-Agent.
+func userAgent(prefix, ua string) string {
+	if prefix == "" {
+		return ua
+	}
+	return prefix + " " + ua
 }
@@ -0,0 +1,943 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/upjet/pkg/terraform"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+)
+
+const (
+	testNamespace = "crossplane-system"
+	testPCName    = "default"
+)
+
+// newClient returns a fake client serving the supplied objects.
+func newClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return clientfake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+// newSecret returns a Secret in testNamespace with the supplied data.
+func newSecret(name string, data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+// newProviderConfig returns a ProviderConfig reading its credentials from
+// the credentials key of the Secret with the supplied name.
+func newProviderConfig(secret string, mod ...func(pc *v1beta1.ProviderConfig)) *v1beta1.ProviderConfig {
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: testPCName},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: secret},
+						Key:             "credentials",
+					},
+				},
+			},
+		},
+	}
+	for _, m := range mod {
+		m(pc)
+	}
+	return pc
+}
+
+// newManaged returns a managed resource using the ProviderConfig named
+// testPCName with the supplied annotations.
+func newManaged(annotations map[string]string) *fake.Managed {
+	return &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example",
+			UID:         "11111111-2222-3333-4444-555555555555",
+			Annotations: annotations,
+		},
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: testPCName}},
+	}
+}
+
+// overrideAnnotations returns the annotations that make a managed resource
+// read its credentials from the Secret with the supplied name.
+func overrideAnnotations(name string) map[string]string {
+	return map[string]string{
+		AnnotationKeyCredentialsSecretName:      name,
+		AnnotationKeyCredentialsSecretNamespace: testNamespace,
+	}
+}
+
+func TestTerraformSetup(t *testing.T) {
+	cases := map[string]struct {
+		reason          string
+		version         string
+		providerSource  string
+		providerVersion string
+	}{
+		"Default": {
+			reason:          "The Terraform CLI and provider versions should reach the setup.",
+			version:         "1.5.7",
+			providerSource:  "tailscale/tailscale",
+			providerVersion: "0.16.1",
+		},
+		"PinnedCLI": {
+			reason:          "A pinned Terraform CLI version should reach the setup independently of the provider version.",
+			version:         "1.3.9",
+			providerSource:  "registry.example.com/tailscale/tailscale",
+			providerVersion: "0.16.1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, newProviderConfig("default"), newSecret("default", map[string]string{"credentials": `{"api_key":"key"}`}))
+			ps, err := TerraformSetupBuilder(tc.version, tc.providerSource, tc.providerVersion)(context.Background(), c, newManaged(nil))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			want := terraform.ProviderRequirement{Source: tc.providerSource, Version: tc.providerVersion}
+			if ps.Version != tc.version {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): want Terraform version %q, got %q", tc.reason, tc.version, ps.Version)
+			}
+			if diff := cmp.Diff(want, ps.Requirement); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want provider requirement, +got provider requirement:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialsOverride(t *testing.T) {
+	errBoom := errors.Errorf(errFmtOverrideNotAllowed, testPCName, testNamespace, "override")
+
+	allowSecret := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{
+			AllowedSecrets: []xpv1.SecretReference{{Namespace: testNamespace, Name: "override"}},
+		}
+	}
+	allowNamespace := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{
+			AllowedNamespaces: []string{testNamespace},
+		}
+	}
+	allowOther := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{
+			AllowedSecrets:    []xpv1.SecretReference{{Namespace: testNamespace, Name: "other"}},
+			AllowedNamespaces: []string{"other"},
+		}
+	}
+
+	type args struct {
+		pc          *v1beta1.ProviderConfig
+		annotations map[string]string
+	}
+	type want struct {
+		cfg map[string]any
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Default": {
+			reason: "A managed resource without override annotations should use the credentials of its ProviderConfig.",
+			args: args{
+				pc: newProviderConfig("default", allowSecret),
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "default", keyUserAgent: defaultUserAgent()},
+			},
+		},
+		"AllowedSecret": {
+			reason: "A managed resource should use the overriding Secret if its ProviderConfig allows that Secret.",
+			args: args{
+				pc:          newProviderConfig("default", allowSecret),
+				annotations: overrideAnnotations("override"),
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "override", keyUserAgent: defaultUserAgent()},
+			},
+		},
+		"AllowedNamespace": {
+			reason: "A managed resource should use the overriding Secret if its ProviderConfig allows the Secret's namespace.",
+			args: args{
+				pc:          newProviderConfig("default", allowNamespace),
+				annotations: overrideAnnotations("override"),
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "override", keyUserAgent: defaultUserAgent()},
+			},
+		},
+		"NotAllowed": {
+			reason: "A managed resource should be rejected if its ProviderConfig allows neither the overriding Secret nor its namespace.",
+			args: args{
+				pc:          newProviderConfig("default", allowOther),
+				annotations: overrideAnnotations("override"),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"NoResourceCredentials": {
+			reason: "A managed resource should be rejected if its ProviderConfig does not allow overrides at all.",
+			args: args{
+				pc:          newProviderConfig("default"),
+				annotations: overrideAnnotations("override"),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, tc.args.pc,
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}),
+				newSecret("override", map[string]string{"credentials": `{"api_key":"override"}`}),
+			)
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(tc.args.annotations))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, map[string]any(ps.Configuration)); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOAuthWithOverride(t *testing.T) {
+	withOAuth := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+			ClientIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "id",
+			},
+			ClientSecretSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "secret",
+			},
+			Scopes: []string{"devices:core"},
+		}
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{AllowedNamespaces: []string{testNamespace}}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		want        map[string]any
+	}{
+		"ProviderConfigOAuth": {
+			reason: "The OAuth client of the ProviderConfig should replace the API key of its credentials.",
+			want: map[string]any{
+				keyOAuthClientID:     "id",
+				keyOAuthClientSecret: "sec",
+				keyOAuthScopes:       []string{"devices:core"},
+				keyUserAgent:         defaultUserAgent(),
+			},
+		},
+		"Override": {
+			reason:      "The OAuth client of the ProviderConfig should not replace the API key of overriding credentials.",
+			annotations: overrideAnnotations("override"),
+			want: map[string]any{
+				keyAPIKey:    "override",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, newProviderConfig("default", withOAuth),
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}),
+				newSecret("override", map[string]string{"credentials": `{"api_key":"override"}`}),
+				newSecret("oauth", map[string]string{"id": "id", "secret": "sec"}),
+			)
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(tc.annotations))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, map[string]any(ps.Configuration)); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAdditionalSecretRefs(t *testing.T) {
+	ref := func(name string) xpv1.SecretKeySelector {
+		return xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: name},
+			Key:             "credentials",
+		}
+	}
+	withAdditional := func(names ...string) func(pc *v1beta1.ProviderConfig) {
+		return func(pc *v1beta1.ProviderConfig) {
+			for _, n := range names {
+				pc.Spec.Credentials.AdditionalSecretRefs = append(pc.Spec.Credentials.AdditionalSecretRefs, ref(n))
+			}
+			pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{AllowedNamespaces: []string{testNamespace}}
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		pc          *v1beta1.ProviderConfig
+		annotations map[string]string
+		want        map[string]any
+	}{
+		"Merge": {
+			reason: "Keys of additional Secrets should be merged into those of the credentials Secret.",
+			pc:     newProviderConfig("default", withAdditional("endpoint")),
+			want: map[string]any{
+				keyAPIKey:    "default",
+				keyBaseURL:   "https://endpoint.example.com",
+				keyTailnet:   "endpoint.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"ConflictWithCredentials": {
+			reason: "A key of an additional Secret should take precedence over the same key of the credentials Secret.",
+			pc:     newProviderConfig("default", withAdditional("key")),
+			want: map[string]any{
+				keyAPIKey:    "additional",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"ConflictBetweenAdditional": {
+			reason: "When several additional Secrets set the same key the one listed last should win.",
+			pc:     newProviderConfig("default", withAdditional("endpoint", "other-endpoint")),
+			want: map[string]any{
+				keyAPIKey:    "default",
+				keyBaseURL:   "https://other.example.com",
+				keyTailnet:   "endpoint.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"Override": {
+			reason:      "Additional Secrets should not be merged into overriding credentials.",
+			pc:          newProviderConfig("default", withAdditional("endpoint", "key")),
+			annotations: overrideAnnotations("override"),
+			want: map[string]any{
+				keyAPIKey:    "override",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, tc.pc,
+				newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}),
+				newSecret("override", map[string]string{"credentials": `{"api_key":"override"}`}),
+				newSecret("key", map[string]string{"credentials": `{"api_key":"additional"}`}),
+				newSecret("endpoint", map[string]string{"credentials": `{"base_url":"https://endpoint.example.com","tailnet":"endpoint.example.com"}`}),
+				newSecret("other-endpoint", map[string]string{"credentials": `{"base_url":"https://other.example.com"}`}),
+			)
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(tc.annotations))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, map[string]any(ps.Configuration)); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialPrecedence(t *testing.T) {
+	additional := func(names ...string) func(pc *v1beta1.ProviderConfig) {
+		return func(pc *v1beta1.ProviderConfig) {
+			for _, n := range names {
+				pc.Spec.Credentials.AdditionalSecretRefs = append(pc.Spec.Credentials.AdditionalSecretRefs, xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: n},
+					Key:             "credentials",
+				})
+			}
+		}
+	}
+	spec := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.BaseURL = "https://spec.example.com"
+		pc.Spec.Tailnet = "spec.example.com"
+	}
+	oauth := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.OAuth = &v1beta1.ProviderOAuth{
+			ClientIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "id",
+			},
+			ClientSecretSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "oauth"},
+				Key:             "secret",
+			},
+		}
+	}
+	fallback := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.FallbackBaseURLs = []string{"https://fallback.example.com"}
+		pc.Status.BaseURL = "https://fallback.example.com"
+	}
+	allowOverride := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.ResourceCredentials = &v1beta1.ResourceCredentials{AllowedNamespaces: []string{testNamespace}}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		mods        []func(pc *v1beta1.ProviderConfig)
+		annotations map[string]string
+		want        map[string]any
+	}{
+		"Source": {
+			reason: "The credentials source should supply every key nothing else sets.",
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://source.example.com",
+				keyTailnet:   "source.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"AdditionalSecretOverSource": {
+			reason: "An additional Secret should take precedence over the credentials source.",
+			mods:   []func(pc *v1beta1.ProviderConfig){additional("first")},
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://source.example.com",
+				keyTailnet:   "first.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"LaterAdditionalSecret": {
+			reason: "An additional Secret should take precedence over those listed before it.",
+			mods:   []func(pc *v1beta1.ProviderConfig){additional("first", "second")},
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://source.example.com",
+				keyTailnet:   "second.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"SpecOverSecrets": {
+			reason: "The base URL and tailnet of the ProviderConfig should take precedence over every Secret.",
+			mods:   []func(pc *v1beta1.ProviderConfig){additional("first"), spec},
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://spec.example.com",
+				keyTailnet:   "spec.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"OAuthOverAPIKey": {
+			reason: "The OAuth client of the ProviderConfig should replace the API key read from Secrets.",
+			mods:   []func(pc *v1beta1.ProviderConfig){oauth},
+			want: map[string]any{
+				keyOAuthClientID:     "id",
+				keyOAuthClientSecret: "sec",
+				keyBaseURL:           "https://source.example.com",
+				keyTailnet:           "source.example.com",
+				keyUserAgent:         defaultUserAgent(),
+			},
+		},
+		"FallbackOverSpec": {
+			reason: "A fallback base URL selected by the credentials check should take precedence over the base URL of the ProviderConfig.",
+			mods:   []func(pc *v1beta1.ProviderConfig){spec, fallback},
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://fallback.example.com",
+				keyTailnet:   "spec.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"AnnotationOverSpec": {
+			reason: "A configuration annotation of the managed resource should take precedence over the ProviderConfig.",
+			mods:   []func(pc *v1beta1.ProviderConfig){spec},
+			annotations: map[string]string{
+				AnnotationPrefixConfig + keyTailnet: "annotation.example.com",
+			},
+			want: map[string]any{
+				keyAPIKey:    "source",
+				keyBaseURL:   "https://spec.example.com",
+				keyTailnet:   "annotation.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+		"Override": {
+			reason:      "Overriding credentials should replace the credentials source, additional Secrets and OAuth client, but not the rest of the ProviderConfig.",
+			mods:        []func(pc *v1beta1.ProviderConfig){additional("first"), oauth, spec, allowOverride},
+			annotations: overrideAnnotations("override"),
+			want: map[string]any{
+				keyAPIKey:    "override",
+				keyBaseURL:   "https://spec.example.com",
+				keyTailnet:   "spec.example.com",
+				keyUserAgent: defaultUserAgent(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, newProviderConfig("default", tc.mods...),
+				newSecret("default", map[string]string{"credentials": `{"api_key":"source","base_url":"https://source.example.com","tailnet":"source.example.com"}`}),
+				newSecret("first", map[string]string{"credentials": `{"tailnet":"first.example.com"}`}),
+				newSecret("second", map[string]string{"credentials": `{"tailnet":"second.example.com"}`}),
+				newSecret("override", map[string]string{"credentials": `{"api_key":"override"}`}),
+				newSecret("oauth", map[string]string{"id": "id", "secret": "sec"}),
+			)
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(tc.annotations))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, map[string]any(ps.Configuration)); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFallbackBaseURLs(t *testing.T) {
+	withFallbacks := func(selected string) func(pc *v1beta1.ProviderConfig) {
+		return func(pc *v1beta1.ProviderConfig) {
+			pc.Spec.BaseURL = "https://primary.example.com"
+			pc.Spec.FallbackBaseURLs = []string{"https://secondary.example.com", "https://tertiary.example.com"}
+			pc.Status.BaseURL = selected
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     *v1beta1.ProviderConfig
+		want   string
+	}{
+		"Unchecked": {
+			reason: "The base URL should be used until a credentials check selected another.",
+			pc:     newProviderConfig("default", withFallbacks("")),
+			want:   "https://primary.example.com",
+		},
+		"Primary": {
+			reason: "The base URL should be used while it answers the credentials check.",
+			pc:     newProviderConfig("default", withFallbacks("https://primary.example.com")),
+			want:   "https://primary.example.com",
+		},
+		"Fallback": {
+			reason: "The fallback base URL that answered the last credentials check should be used.",
+			pc:     newProviderConfig("default", withFallbacks("https://tertiary.example.com")),
+			want:   "https://tertiary.example.com",
+		},
+		"Removed": {
+			reason: "A selected base URL that is no longer a fallback should be ignored.",
+			pc:     newProviderConfig("default", withFallbacks("https://removed.example.com")),
+			want:   "https://primary.example.com",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, tc.pc, newSecret("default", map[string]string{"credentials": `{"api_key":"default"}`}))
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(nil))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, ps.Configuration[keyBaseURL]); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want base URL, +got base URL:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialFormats(t *testing.T) {
+	apiKeyRef := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.Credentials.SecretRef.Key = keyAPIKey
+	}
+
+	type want struct {
+		cfg map[string]any
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		pc     *v1beta1.ProviderConfig
+		data   map[string]string
+		want   want
+	}{
+		"JSON": {
+			reason: "Keys of a JSON object should be passed on, with scopes as a list.",
+			pc:     newProviderConfig("default"),
+			data:   map[string]string{"credentials": `{"api_key":"k","scopes":["devices:core"],"tailnet":"t"}`},
+			want: want{
+				cfg: map[string]any{
+					keyAPIKey:      "k",
+					keyOAuthScopes: []string{"devices:core"},
+					keyTailnet:     "t",
+					keyUserAgent:   defaultUserAgent(),
+				},
+			},
+		},
+		"JSONStringTypeError": {
+			reason: "A JSON object with a non-string API key should be rejected.",
+			pc:     newProviderConfig("default"),
+			data:   map[string]string{"credentials": `{"api_key":5}`},
+			want: want{
+				err: errors.Errorf(errFmtCredentialsKeyType, keyAPIKey, "string"),
+			},
+		},
+		"JSONScopesTypeError": {
+			reason: "A JSON object whose scopes are not a list of strings should be rejected rather than read key by key.",
+			pc:     newProviderConfig("default"),
+			data:   map[string]string{"credentials": `{"api_key":"k","scopes":"devices:core"}`, keyAPIKey: "k"},
+			want: want{
+				err: errors.Errorf(errFmtCredentialsKeyType, keyOAuthScopes, "list of strings"),
+			},
+		},
+		"StructuredKeys": {
+			reason: "A Secret storing one key per configuration key should be read key by key, with scopes split into a list.",
+			pc:     newProviderConfig("default", apiKeyRef),
+			data:   map[string]string{keyAPIKey: "tskey-api-k", keyOAuthScopes: "devices:core, dns:read", keyTailnet: "t"},
+			want: want{
+				cfg: map[string]any{
+					keyAPIKey:      "tskey-api-k",
+					keyOAuthScopes: []string{"devices:core", "dns:read"},
+					keyTailnet:     "t",
+					keyUserAgent:   defaultUserAgent(),
+				},
+			},
+		},
+		"NotJSON": {
+			reason: "A selected key that is not JSON should be reported as such if the Secret has no configuration keys.",
+			pc:     newProviderConfig("default"),
+			data:   map[string]string{"credentials": "garbage"},
+			want: want{
+				err: errors.Wrap(errors.New("invalid character 'g' looking for beginning of value"), errUnmarshalCredentials),
+			},
+		},
+		"NotJSONObject": {
+			reason: "A selected key that is JSON but not an object should be reported as such if the Secret has no configuration keys.",
+			pc:     newProviderConfig("default"),
+			data:   map[string]string{"credentials": `"tskey-api-k"`},
+			want: want{
+				err: errors.Wrap(errors.New(errNotJSONObject), errUnmarshalCredentials),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, tc.pc, newSecret("default", tc.data))
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1")(context.Background(), c, newManaged(nil))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, map[string]any(ps.Configuration)); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVariables(t *testing.T) {
+	for _, k := range configurationKeys {
+		if environmentVariables[k] == "" {
+			t.Errorf("environmentVariables: configuration key %q should have an environment variable", k)
+		}
+	}
+}
+
+func TestEnvironmentCredentials(t *testing.T) {
+	environment := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.Credentials = v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment}
+	}
+	additional := func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.Credentials.AdditionalSecretRefs = []xpv1.SecretKeySelector{{
+			SecretReference: xpv1.SecretReference{Namespace: testNamespace, Name: "additional"},
+			Key:             "credentials",
+		}}
+	}
+
+	type want struct {
+		cfg map[string]any
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		env    map[string]string
+		mods   []func(pc *v1beta1.ProviderConfig)
+		want   want
+	}{
+		"APIKey": {
+			reason: "An API key and tailnet should be read from the environment.",
+			env: map[string]string{
+				"TAILSCALE_API_KEY": "env-key",
+				"TAILSCALE_TAILNET": "env.example.com",
+			},
+			want: want{cfg: map[string]any{keyAPIKey: "env-key", keyTailnet: "env.example.com"}},
+		},
+		"OAuth": {
+			reason: "An OAuth client and its scopes should be read from the environment.",
+			env: map[string]string{
+				"TAILSCALE_OAUTH_CLIENT_ID":     "env-id",
+				"TAILSCALE_OAUTH_CLIENT_SECRET": "env-secret",
+				"TAILSCALE_OAUTH_SCOPES":        "devices:core, dns",
+			},
+			want: want{cfg: map[string]any{
+				keyOAuthClientID:     "env-id",
+				keyOAuthClientSecret: "env-secret",
+				keyOAuthScopes:       []string{"devices:core", "dns"},
+			}},
+		},
+		"BaseURLAndUserAgent": {
+			reason: "Every configuration key should have an environment variable.",
+			env: map[string]string{
+				"TAILSCALE_API_KEY":    "env-key",
+				"TAILSCALE_BASE_URL":   "https://env.example.com",
+				"TAILSCALE_USER_AGENT": "env/1.0",
+			},
+			want: want{cfg: map[string]any{keyAPIKey: "env-key", keyBaseURL: "https://env.example.com", keyUserAgent: "env/1.0"}},
+		},
+		"NoVariables": {
+			reason: "An error should be returned if none of the variables is set.",
+			want:   want{err: errors.New(errNoEnvironmentCredentials)},
+		},
+		"AdditionalSecrets": {
+			reason: "Additional Secrets should take precedence over the environment.",
+			env: map[string]string{
+				"TAILSCALE_API_KEY": "env-key",
+				"TAILSCALE_TAILNET": "env.example.com",
+			},
+			mods: []func(pc *v1beta1.ProviderConfig){additional},
+			want: want{cfg: map[string]any{keyAPIKey: "env-key", keyTailnet: "additional.example.com"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range environmentVariables {
+				t.Setenv(env, "")
+			}
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			pc := newProviderConfig("default", append([]func(pc *v1beta1.ProviderConfig){environment}, tc.mods...)...)
+			c := newClient(t, newSecret("additional", map[string]string{"credentials": `{"tailnet":"additional.example.com"}`}))
+			cfg, err := providerConfiguration(context.Background(), c, pc, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nproviderConfiguration(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, cfg); diff != "" {
+				t.Errorf("\n%s\nproviderConfiguration(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigFromAnnotations(t *testing.T) {
+	type want struct {
+		cfg map[string]any
+		err error
+	}
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		want        want
+	}{
+		"NoAnnotations": {
+			reason: "A managed resource without annotations should not change the configuration.",
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key"},
+			},
+		},
+		"AllowedKeys": {
+			reason: "The tailnet and User-Agent should be overridable through annotations.",
+			annotations: map[string]string{
+				AnnotationPrefixConfig + keyTailnet:   "example.com",
+				AnnotationPrefixConfig + keyUserAgent: "custom/1.0",
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key", keyTailnet: "example.com", keyUserAgent: "custom/1.0"},
+			},
+		},
+		"OtherAnnotations": {
+			reason: "Annotations without the configuration prefix should be ignored.",
+			annotations: map[string]string{
+				"tailscale.crossplane.io/" + keyAPIKey: "other",
+				"example.com/" + keyTailnet:            "other",
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key"},
+			},
+		},
+		"Credentials": {
+			reason: "Credentials should not be settable through annotations.",
+			annotations: map[string]string{
+				AnnotationPrefixConfig + keyAPIKey: "other",
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key"},
+				err: errors.Errorf(errFmtAnnotationConfigKey, keyAPIKey),
+			},
+		},
+		"BaseURL": {
+			reason: "The API endpoint should not be settable through annotations.",
+			annotations: map[string]string{
+				AnnotationPrefixConfig + keyBaseURL: "https://example.com",
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key"},
+				err: errors.Errorf(errFmtAnnotationConfigKey, keyBaseURL),
+			},
+		},
+		"UnknownKey": {
+			reason: "Keys the Terraform provider does not know should be rejected.",
+			annotations: map[string]string{
+				AnnotationPrefixConfig + "unknown": "value",
+			},
+			want: want{
+				cfg: map[string]any{keyAPIKey: "key"},
+				err: errors.Errorf(errFmtAnnotationConfigKey, "unknown"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := map[string]any{keyAPIKey: "key"}
+			err := configFromAnnotations(newManaged(tc.annotations), cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nconfigFromAnnotations(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, cfg); diff != "" {
+				t.Errorf("\n%s\nconfigFromAnnotations(...): -want configuration, +got configuration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cfg    map[string]any
+		want   error
+	}{
+		"APIKey": {
+			reason: "An API key alone should be usable.",
+			cfg:    map[string]any{keyAPIKey: "key", keyTailnet: "example.com"},
+		},
+		"OAuth": {
+			reason: "An OAuth client with its ID and secret should be usable.",
+			cfg:    map[string]any{keyOAuthClientID: "id", keyOAuthClientSecret: "secret"},
+		},
+		"APIKeyAndOAuthClientID": {
+			reason: "An API key should not be usable alongside an OAuth client.",
+			cfg:    map[string]any{keyAPIKey: "key", keyOAuthClientID: "id", keyOAuthClientSecret: "secret"},
+			want:   errors.Errorf(errFmtConflictingKeys, keyAPIKey, keyOAuthClientID),
+		},
+		"APIKeyAndOAuthClientSecret": {
+			reason: "An API key should not be usable alongside an OAuth client secret.",
+			cfg:    map[string]any{keyAPIKey: "key", keyOAuthClientSecret: "secret"},
+			want:   errors.Errorf(errFmtConflictingKeys, keyAPIKey, keyOAuthClientSecret),
+		},
+		"NoOAuthClientSecret": {
+			reason: "An OAuth client ID should require its secret.",
+			cfg:    map[string]any{keyOAuthClientID: "id"},
+			want:   errors.Errorf(errFmtMissingKey, keyOAuthClientID, keyOAuthClientSecret),
+		},
+		"NoOAuthClientID": {
+			reason: "An OAuth client secret should require its ID.",
+			cfg:    map[string]any{keyOAuthClientSecret: "secret"},
+			want:   errors.Errorf(errFmtMissingKey, keyOAuthClientSecret, keyOAuthClientID),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateConfiguration(tc.cfg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidateConfiguration(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	type args struct {
+		prefix      string
+		credentials string
+		annotations map[string]string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"Default": {
+			reason: "Without a prefix or configured User-Agent the provider's own User-Agent should be used.",
+			args: args{
+				credentials: `{"api_key":"key"}`,
+			},
+			want: defaultUserAgent(),
+		},
+		"PrefixDefault": {
+			reason: "The prefix should be prepended to the provider's own User-Agent.",
+			args: args{
+				prefix:      "cluster/east",
+				credentials: `{"api_key":"key"}`,
+			},
+			want: "cluster/east " + defaultUserAgent(),
+		},
+		"Credentials": {
+			reason: "A User-Agent set in the credentials should replace the provider's own without a prefix.",
+			args: args{
+				credentials: `{"api_key":"key","user_agent":"creds/1.0"}`,
+			},
+			want: "creds/1.0",
+		},
+		"PrefixCredentials": {
+			reason: "The prefix should be prepended to a User-Agent set in the credentials.",
+			args: args{
+				prefix:      "cluster/east",
+				credentials: `{"api_key":"key","user_agent":"creds/1.0"}`,
+			},
+			want: "cluster/east creds/1.0",
+		},
+		"PrefixAnnotation": {
+			reason: "The prefix should be prepended to a User-Agent set through an annotation, which takes precedence over the credentials.",
+			args: args{
+				prefix:      "cluster/east",
+				credentials: `{"api_key":"key","user_agent":"creds/1.0"}`,
+				annotations: map[string]string{AnnotationPrefixConfig + keyUserAgent: "annotation/1.0"},
+			},
+			want: "cluster/east annotation/1.0",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, newProviderConfig("default"), newSecret("default", map[string]string{"credentials": tc.args.credentials}))
+			ps, err := TerraformSetupBuilder("1.5.7", "tailscale/tailscale", "0.16.1", WithUserAgentPrefix(tc.args.prefix))(context.Background(), c, newManaged(tc.args.annotations))
+			if err != nil {
+				t.Fatalf("\n%s\nTerraformSetupBuilder(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, ps.Configuration[keyUserAgent]); diff != "" {
+				t.Errorf("\n%s\nTerraformSetupBuilder(...): -want User-Agent, +got User-Agent:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	aclv1alpha1 "github.com/supahlab/provider-tailscale/apis/acl/v1alpha1"
+	devicev1alpha1 "github.com/supahlab/provider-tailscale/apis/device/v1alpha1"
+	dnsv1alpha1 "github.com/supahlab/provider-tailscale/apis/dns/v1alpha1"
+	logstreamv1alpha1 "github.com/supahlab/provider-tailscale/apis/logstream/v1alpha1"
+	oauthv1alpha1 "github.com/supahlab/provider-tailscale/apis/oauth/v1alpha1"
+	posturev1alpha1 "github.com/supahlab/provider-tailscale/apis/posture/v1alpha1"
+	tailnetv1alpha1 "github.com/supahlab/provider-tailscale/apis/tailnet/v1alpha1"
+	webhookv1alpha1 "github.com/supahlab/provider-tailscale/apis/webhook/v1alpha1"
+	"github.com/supahlab/provider-tailscale/config"
+	"github.com/supahlab/provider-tailscale/internal/controller/acl/acl"
+	"github.com/supahlab/provider-tailscale/internal/controller/device/deviceauthorization"
+	"github.com/supahlab/provider-tailscale/internal/controller/device/devicekey"
+	"github.com/supahlab/provider-tailscale/internal/controller/device/devicesubnetroutes"
+	"github.com/supahlab/provider-tailscale/internal/controller/device/devicetags"
+	"github.com/supahlab/provider-tailscale/internal/controller/dns/dnsnameservers"
+	"github.com/supahlab/provider-tailscale/internal/controller/dns/dnspreferences"
+	"github.com/supahlab/provider-tailscale/internal/controller/dns/dnssearchpaths"
+	"github.com/supahlab/provider-tailscale/internal/controller/dns/dnssplitnameservers"
+	"github.com/supahlab/provider-tailscale/internal/controller/logstream/awsexternalid"
+	"github.com/supahlab/provider-tailscale/internal/controller/logstream/logstreamconfiguration"
+	"github.com/supahlab/provider-tailscale/internal/controller/oauth/oauthclient"
+	"github.com/supahlab/provider-tailscale/internal/controller/posture/postureintegration"
+	"github.com/supahlab/provider-tailscale/internal/controller/providerconfig"
+	"github.com/supahlab/provider-tailscale/internal/controller/tailnet/contacts"
+	"github.com/supahlab/provider-tailscale/internal/controller/tailnet/tailnetkey"
+	"github.com/supahlab/provider-tailscale/internal/controller/tailnet/tailnetsettings"
+	"github.com/supahlab/provider-tailscale/internal/controller/webhook/webhook"
+	"github.com/supahlab/provider-tailscale/internal/features"
+)
+
+const errFmtNoSetup = "no controller is known for %s"
+
+// setups are the Setup functions of the generated managed resource
+// controllers, keyed by the kind they reconcile. TestSetups fails if a
+// resource of the provider is missing.
+var setups = map[schema.GroupVersionKind]func(ctrl.Manager, controller.Options) error{
+	aclv1alpha1.ACL_GroupVersionKind:                          acl.Setup,
+	devicev1alpha1.DeviceAuthorization_GroupVersionKind:       deviceauthorization.Setup,
+	devicev1alpha1.DeviceKey_GroupVersionKind:                 devicekey.Setup,
+	devicev1alpha1.DeviceSubnetRoutes_GroupVersionKind:        devicesubnetroutes.Setup,
+	devicev1alpha1.DeviceTags_GroupVersionKind:                devicetags.Setup,
+	dnsv1alpha1.DNSNameservers_GroupVersionKind:               dnsnameservers.Setup,
+	dnsv1alpha1.DNSPreferences_GroupVersionKind:               dnspreferences.Setup,
+	dnsv1alpha1.DNSSearchPaths_GroupVersionKind:               dnssearchpaths.Setup,
+	dnsv1alpha1.DNSSplitNameservers_GroupVersionKind:          dnssplitnameservers.Setup,
+	logstreamv1alpha1.AWSExternalID_GroupVersionKind:          awsexternalid.Setup,
+	logstreamv1alpha1.LogstreamConfiguration_GroupVersionKind: logstreamconfiguration.Setup,
+	oauthv1alpha1.OAuthClient_GroupVersionKind:                oauthclient.Setup,
+	posturev1alpha1.PostureIntegration_GroupVersionKind:       postureintegration.Setup,
+	tailnetv1alpha1.Contacts_GroupVersionKind:                 contacts.Setup,
+	tailnetv1alpha1.TailnetKey_GroupVersionKind:               tailnetkey.Setup,
+	tailnetv1alpha1.TailnetSettings_GroupVersionKind:          tailnetsettings.Setup,
+	webhookv1alpha1.Webhook_GroupVersionKind:                  webhook.Setup,
+}
+
+// DisabledResources returns the Terraform resources whose managed resources
+// are not reconciled with the supplied options: the alpha resources, unless
+// the EnableAlphaResources feature is enabled.
+func DisabledResources(o controller.Options) map[string]bool {
+	if o.Features.Enabled(features.EnableAlphaResources) {
+		return nil
+	}
+	return config.AlphaResources
+}
+
+// SetupGated adds the ProviderConfig controller and the controllers of the
+// managed resources of the supplied provider, except those of the supplied
+// disabled Terraform resources.
+func SetupGated(mgr ctrl.Manager, o controller.Options, disabled map[string]bool) error {
+	if err := providerconfig.Setup(mgr, o); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(o.Provider.Resources))
+	for name := range o.Provider.Resources {
+		if !disabled[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		gvk := gvkOf(o.Provider, o.Provider.Resources[name])
+		setup, ok := setups[gvk]
+		if !ok {
+			return errors.Errorf(errFmtNoSetup, gvk)
+		}
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpproviderconfig "github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	tjcontroller "github.com/crossplane/upjet/pkg/controller"
+	"github.com/crossplane/upjet/pkg/terraform"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/supahlab/provider-tailscale/apis"
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/config"
+	"github.com/supahlab/provider-tailscale/internal/features"
+)
+
+// recordingManager records what is added to it instead of running it.
+type recordingManager struct {
+	ctrl.Manager
+	controllers []string
+	stateLists  []string
+	webhooks    []string
+}
+
+func (m *recordingManager) Add(r manager.Runnable) error {
+	switch r := r.(type) {
+	case ctrlcontroller.Controller:
+		// The Controller interface does not expose the name it was built with.
+		m.controllers = append(m.controllers, reflect.ValueOf(r).Elem().FieldByName("Name").String())
+	case *statemetrics.MRStateRecorder:
+		// Nor does the recorder expose the kind of list it polls.
+		m.stateLists = append(m.stateLists, reflect.ValueOf(r).Elem().FieldByName("managedList").Elem().Type().Elem().Name())
+	default:
+		return fmt.Errorf("unexpected runnable %T", r)
+	}
+	return nil
+}
+
+func (m *recordingManager) GetWebhookServer() webhook.Server {
+	return recordingWebhookServer{Server: m.Manager.GetWebhookServer(), m: m}
+}
+
+type recordingWebhookServer struct {
+	webhook.Server
+	m *recordingManager
+}
+
+func (s recordingWebhookServer) Register(path string, _ http.Handler) {
+	s.m.webhooks = append(s.m.webhooks, path)
+}
+
+func newRecordingManager(t *testing.T) *recordingManager {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	// Nothing is started, so the API server is never contacted.
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "https://127.0.0.1:1"}, ctrl.Options{
+		Scheme:                 s,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		WebhookServer:          webhook.NewServer(webhook.Options{}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &recordingManager{Manager: mgr}
+}
+
+func TestSetupGated(t *testing.T) {
+	pc := config.GetProvider()
+
+	cases := map[string]struct {
+		reason   string
+		disabled map[string]bool
+	}{
+		"AlphaResources": {
+			reason:   "The controller, state metrics and webhooks of alpha resources should be dropped, and everything else added.",
+			disabled: config.AlphaResources,
+		},
+		"ACL": {
+			reason:   "The controller, state metrics and validating webhook of a disabled ACL should be dropped, and everything else added.",
+			disabled: map[string]bool{"tailscale_acl": true},
+		},
+		"None": {
+			reason: "Everything should be added if nothing is disabled.",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mgr := newRecordingManager(t)
+			o := tjcontroller.Options{
+				Options: xpcontroller.Options{
+					Logger:   logging.NewNopLogger(),
+					Features: &feature.Flags{},
+					MetricOptions: &xpcontroller.MetricOptions{
+						MRMetrics:      managed.NewMRMetricRecorder(),
+						MRStateMetrics: statemetrics.NewMRStateMetrics(),
+					},
+				},
+				Provider:       pc,
+				WorkspaceStore: terraform.NewWorkspaceStore(logging.NewNopLogger()),
+				StartWebhooks:  true,
+			}
+			if err := SetupGated(mgr, o, tc.disabled); err != nil {
+				t.Fatalf("\n%s\nSetupGated(...): unexpected error: %v", tc.reason, err)
+			}
+
+			wantControllers := []string{xpproviderconfig.ControllerName(v1beta1.ProviderConfigGroupKind)}
+			var wantStateLists, wantWebhooks []string
+			for n, r := range pc.Resources {
+				if tc.disabled[n] {
+					continue
+				}
+				gvk := gvkOf(pc, r)
+				wantControllers = append(wantControllers, managed.ControllerName(gvk.String()))
+				wantStateLists = append(wantStateLists, gvk.Kind+"List")
+				obj, err := mgr.GetScheme().New(gvk)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, ok := obj.(admission.Validator); ok {
+					wantWebhooks = append(wantWebhooks, "/validate-"+strings.ReplaceAll(gvk.Group, ".", "-")+"-"+gvk.Version+"-"+strings.ToLower(gvk.Kind))
+				}
+			}
+
+			sorted := func(s []string) []string {
+				s = append([]string(nil), s...)
+				sort.Strings(s)
+				return s
+			}
+			if diff := cmp.Diff(sorted(wantControllers), sorted(mgr.controllers)); diff != "" {
+				t.Errorf("\n%s\nSetupGated(...): -want controllers, +got controllers:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(sorted(wantStateLists), sorted(mgr.stateLists)); diff != "" {
+				t.Errorf("\n%s\nSetupGated(...): -want state metrics recorders, +got state metrics recorders:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(sorted(wantWebhooks), sorted(mgr.webhooks)); diff != "" {
+				t.Errorf("\n%s\nSetupGated(...): -want webhooks, +got webhooks:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetups(t *testing.T) {
+	pc := config.GetProvider()
+
+	want := make([]string, 0, len(pc.Resources))
+	for _, r := range pc.Resources {
+		want = append(want, gvkOf(pc, r).String())
+	}
+	got := make([]string, 0, len(setups))
+	for gvk := range setups {
+		got = append(got, gvk.String())
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nEvery managed resource of the provider should have exactly one controller Setup.\nsetups: -want kinds, +got kinds:\n%s\n", diff)
+	}
+}
+
+func TestDisabledResources(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		flags  []feature.Flag
+		want   map[string]bool
+	}{
+		"Default": {
+			reason: "The alpha resources should be disabled by default.",
+			want:   config.AlphaResources,
+		},
+		"AlphaResourcesEnabled": {
+			reason: "Nothing should be disabled if the EnableAlphaResources feature is enabled.",
+			flags:  []feature.Flag{features.EnableAlphaResources},
+		},
+		"OtherFeatureEnabled": {
+			reason: "Other features should not enable the alpha resources.",
+			flags:  []feature.Flag{features.EnableBetaManagementPolicies},
+			want:   config.AlphaResources,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := &feature.Flags{}
+			for _, flag := range tc.flags {
+				f.Enable(flag)
+			}
+			got := DisabledResources(tjcontroller.Options{Options: xpcontroller.Options{Features: f}})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDisabledResources(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
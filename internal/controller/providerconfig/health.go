@@ -0,0 +1,141 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/upjet/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/internal/clients"
+)
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+)
+
+// TypeCredentialsValid indicates whether the Tailscale API accepts the
+// credentials of a ProviderConfig.
+const TypeCredentialsValid xpv1.ConditionType = "CredentialsValid"
+
+// Reasons a ProviderConfig's credentials are or are not valid.
+const (
+	ReasonCredentialsAccepted   xpv1.ConditionReason = "CredentialsAccepted"
+	ReasonCredentialsRejected   xpv1.ConditionReason = "CredentialsRejected"
+	ReasonCredentialsUnverified xpv1.ConditionReason = "CredentialsUnverified"
+)
+
+// healthTimeout bounds a single credentials check.
+const healthTimeout = 30 * time.Second
+
+// SetupHealth adds a controller that periodically checks the credentials of
+// ProviderConfigs against the Tailscale API and reports the result in their
+// CredentialsValid condition. The supplied options should be those the
+// managed resources' Terraform setup is built with.
+func SetupHealth(mgr ctrl.Manager, o controller.Options, opts ...clients.SetupOption) error {
+	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind) + "/health"
+
+	r := &healthReconciler{
+		client: mgr.GetClient(),
+		http:   &http.Client{Timeout: healthTimeout},
+		log:    o.Logger.WithValues("controller", name),
+		poll:   o.PollInterval,
+		opts:   opts,
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+type healthReconciler struct {
+	client client.Client
+	http   *http.Client
+	log    logging.Logger
+	poll   time.Duration
+	opts   []clients.SetupOption
+}
+
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	base, err := clients.CheckCredentials(ctx, r.client, r.http, pc, r.opts...)
+	switch {
+	case err == nil:
+		pc.Status.BaseURL = base
+		pc.Status.SetConditions(credentialsAccepted())
+	case clients.IsUnverified(err):
+		log.Debug("Cannot check credentials", "error", err)
+		// Keep the result of the last check, and the base URL that answered
+		// it, through an outage of the API rather than reporting credentials
+		// that worked as rejected.
+		if pc.Status.GetCondition(TypeCredentialsValid).Status != corev1.ConditionUnknown {
+			return reconcile.Result{RequeueAfter: r.poll}, nil
+		}
+		pc.Status.SetConditions(credentialsUnverified(err))
+	default:
+		log.Debug("Credentials check failed", "error", err)
+		pc.Status.BaseURL = base
+		pc.Status.SetConditions(credentialsRejected(err))
+	}
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+func credentialsAccepted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsAccepted,
+	}
+}
+
+func credentialsRejected(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsRejected,
+		Message:            err.Error(),
+	}
+}
+
+func credentialsUnverified(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsValid,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsUnverified,
+		Message:            err.Error(),
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/supahlab/provider-tailscale/apis/v1beta1"
+	"github.com/supahlab/provider-tailscale/internal/clients"
+)
+
+func TestHealthReconcile(t *testing.T) {
+	accepted := xpv1.Condition{Type: TypeCredentialsValid, Status: corev1.ConditionTrue, Reason: ReasonCredentialsAccepted}
+	poll := 10 * time.Minute
+
+	type args struct {
+		code     int
+		previous []xpv1.Condition
+		opts     []clients.SetupOption
+	}
+	type want struct {
+		conditions []xpv1.Condition
+		answered   bool
+		userAgent  string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Accepted": {
+			reason: "Credentials the API accepts should be reported as valid.",
+			args: args{
+				code: http.StatusOK,
+			},
+			want: want{
+				conditions: []xpv1.Condition{accepted},
+				answered:   true,
+			},
+		},
+		"Rejected": {
+			reason: "Credentials the API rejects should be reported as invalid.",
+			args: args{
+				code:     http.StatusUnauthorized,
+				previous: []xpv1.Condition{accepted},
+			},
+			want: want{
+				conditions: []xpv1.Condition{{
+					Type:    TypeCredentialsValid,
+					Status:  corev1.ConditionFalse,
+					Reason:  ReasonCredentialsRejected,
+					Message: "Tailscale API responded with 401 Unauthorized",
+				}},
+				answered: true,
+			},
+		},
+		"UnverifiedWithoutPrevious": {
+			reason: "Credentials that could not be checked should be reported as unknown if they were never checked.",
+			args: args{
+				code: http.StatusServiceUnavailable,
+			},
+			want: want{
+				conditions: []xpv1.Condition{{
+					Type:    TypeCredentialsValid,
+					Status:  corev1.ConditionUnknown,
+					Reason:  ReasonCredentialsUnverified,
+					Message: "Tailscale API responded with 503 Service Unavailable",
+				}},
+			},
+		},
+		"UnverifiedKeepsPrevious": {
+			reason: "Credentials that could not be checked should keep the result of the last check.",
+			args: args{
+				code:     http.StatusInternalServerError,
+				previous: []xpv1.Condition{accepted},
+			},
+			want: want{
+				conditions: []xpv1.Condition{accepted},
+			},
+		},
+		"UserAgentPrefix": {
+			reason: "The check should prepend the provider's User-Agent prefix like managed resources do.",
+			args: args{
+				code: http.StatusOK,
+				opts: []clients.SetupOption{clients.WithUserAgentPrefix("cluster/east")},
+			},
+			want: want{
+				conditions: []xpv1.Condition{accepted},
+				answered:   true,
+				userAgent:  "cluster/east test/1.0",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ua string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ua = r.UserAgent()
+				w.WriteHeader(tc.args.code)
+			}))
+			defer srv.Close()
+
+			s := runtime.NewScheme()
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			pc := &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "default"},
+								Key:             "credentials",
+							},
+						},
+					},
+				},
+			}
+			pc.Status.SetConditions(tc.args.previous...)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "default"},
+				Data: map[string][]byte{
+					"credentials": []byte(`{"base_url":"` + srv.URL + `","api_key":"tskey-api-test","user_agent":"test/1.0"}`),
+				},
+			}
+			c := clientfake.NewClientBuilder().WithScheme(s).WithObjects(pc, secret).WithStatusSubresource(pc).Build()
+
+			r := &healthReconciler{client: c, http: srv.Client(), log: logging.NewNopLogger(), poll: poll, opts: append([]clients.SetupOption{clients.WithCheckBackoff(time.Millisecond)}, tc.args.opts...)}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+			if err != nil {
+				t.Fatalf("\n%s\nReconcile(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(reconcile.Result{RequeueAfter: poll}, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want result, +got result:\n%s\n", tc.reason, diff)
+			}
+
+			pc = &v1beta1.ProviderConfig{}
+			if err := c.Get(context.Background(), types.NamespacedName{Name: "default"}, pc); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.conditions, pc.Status.Conditions, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want conditions, +got conditions:\n%s\n", tc.reason, diff)
+			}
+			want := ""
+			if tc.want.answered {
+				want = srv.URL
+			}
+			if pc.Status.BaseURL != want {
+				t.Errorf("\n%s\nReconcile(...): want status base URL %q, got %q", tc.reason, want, pc.Status.BaseURL)
+			}
+			if tc.want.userAgent != "" && tc.want.userAgent != ua {
+				t.Errorf("\n%s\nReconcile(...): want User-Agent %q, got %q", tc.reason, tc.want.userAgent, ua)
+			}
+		})
+	}
+}
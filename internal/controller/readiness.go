@@ -0,0 +1,59 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	ujconfig "github.com/crossplane/upjet/pkg/config"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+const (
+	errGetInformer = "cannot get informer for %s"
+	errNotSynced   = "informers not synced: %s"
+)
+
+// ReadyzCheck returns a readiness check that only passes once the CRD of
+// every managed resource of the supplied provider, except those of the
+// disabled Terraform resources, is established and the informer its
+// controller watches has synced.
+func ReadyzCheck(mgr ctrl.Manager, pc *ujconfig.Provider, disabled map[string]bool) healthz.Checker {
+	gvks := make([]schema.GroupVersionKind, 0, len(pc.Resources))
+	for name, r := range pc.Resources {
+		if !disabled[name] {
+			gvks = append(gvks, gvkOf(pc, r))
+		}
+	}
+	sort.Slice(gvks, func(i, j int) bool { return gvks[i].String() < gvks[j].String() })
+
+	return func(req *http.Request) error {
+		var pending []string
+		for _, gvk := range gvks {
+			i, err := mgr.GetCache().GetInformerForKind(req.Context(), gvk, cache.BlockUntilSynced(false))
+			if err != nil {
+				return errors.Wrapf(err, errGetInformer, gvk.GroupKind())
+			}
+			if !i.HasSynced() {
+				pending = append(pending, gvk.GroupKind().String())
+			}
+		}
+		if len(pending) > 0 {
+			return errors.Errorf(errNotSynced, strings.Join(pending, ", "))
+		}
+		return nil
+	}
+}
+
+// gvkOf returns the GroupVersionKind of the managed resource of r.
+func gvkOf(pc *ujconfig.Provider, r *ujconfig.Resource) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: r.ShortGroup + "." + pc.RootGroup, Version: r.Version, Kind: r.Kind}
+}
@@ -9,16 +9,48 @@ import (
 
 	"github.com/crossplane/upjet/pkg/controller"
 
-	resource "github.com/supahlab/provider-tailscale/internal/controller/null/resource"
+	acl "github.com/supahlab/provider-tailscale/internal/controller/acl/acl"
+	deviceauthorization "github.com/supahlab/provider-tailscale/internal/controller/device/deviceauthorization"
+	devicekey "github.com/supahlab/provider-tailscale/internal/controller/device/devicekey"
+	devicesubnetroutes "github.com/supahlab/provider-tailscale/internal/controller/device/devicesubnetroutes"
+	devicetags "github.com/supahlab/provider-tailscale/internal/controller/device/devicetags"
+	dnsnameservers "github.com/supahlab/provider-tailscale/internal/controller/dns/dnsnameservers"
+	dnspreferences "github.com/supahlab/provider-tailscale/internal/controller/dns/dnspreferences"
+	dnssearchpaths "github.com/supahlab/provider-tailscale/internal/controller/dns/dnssearchpaths"
+	dnssplitnameservers "github.com/supahlab/provider-tailscale/internal/controller/dns/dnssplitnameservers"
+	awsexternalid "github.com/supahlab/provider-tailscale/internal/controller/logstream/awsexternalid"
+	logstreamconfiguration "github.com/supahlab/provider-tailscale/internal/controller/logstream/logstreamconfiguration"
+	oauthclient "github.com/supahlab/provider-tailscale/internal/controller/oauth/oauthclient"
+	postureintegration "github.com/supahlab/provider-tailscale/internal/controller/posture/postureintegration"
 	providerconfig "github.com/supahlab/provider-tailscale/internal/controller/providerconfig"
+	contacts "github.com/supahlab/provider-tailscale/internal/controller/tailnet/contacts"
+	tailnetkey "github.com/supahlab/provider-tailscale/internal/controller/tailnet/tailnetkey"
+	tailnetsettings "github.com/supahlab/provider-tailscale/internal/controller/tailnet/tailnetsettings"
+	webhook "github.com/supahlab/provider-tailscale/internal/controller/webhook/webhook"
 )
 
 // Setup creates all controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
-		resource.Setup,
+		acl.Setup,
+		deviceauthorization.Setup,
+		devicekey.Setup,
+		devicesubnetroutes.Setup,
+		devicetags.Setup,
+		dnsnameservers.Setup,
+		dnspreferences.Setup,
+		dnssearchpaths.Setup,
+		dnssplitnameservers.Setup,
+		awsexternalid.Setup,
+		logstreamconfiguration.Setup,
+		oauthclient.Setup,
+		postureintegration.Setup,
 		providerconfig.Setup,
+		contacts.Setup,
+		tailnetkey.Setup,
+		tailnetsettings.Setup,
+		webhook.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
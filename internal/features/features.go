@@ -15,6 +15,10 @@ const (
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores xpfeature.Flag = "EnableAlphaExternalSecretStores"
 
+	// EnableAlphaResources enables the controllers of the managed resources
+	// that are still alpha, such as PostureIntegration.
+	EnableAlphaResources xpfeature.Flag = "EnableAlphaResources"
+
 	// EnableBetaManagementPolicies enables beta support for
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/pull/3531
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+// Package hujson validates HuJSON, the JSON superset with comments and
+// trailing commas that Tailscale policy files are written in.
+package hujson
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errSyntax              = "line %d, column %d: %s"
+	errUnterminatedComment = "unterminated comment"
+)
+
+// Validate returns an error naming the line and column of the first syntax
+// error in the supplied HuJSON document, if any.
+func Validate(b []byte) error {
+	var v any
+	return Unmarshal(b, &v)
+}
+
+// Unmarshal parses the supplied HuJSON document into v like json.Unmarshal
+// does for JSON. Syntax errors name their line and column.
+func Unmarshal(b []byte, v any) error {
+	std, err := standardize(b)
+	if err != nil {
+		return err
+	}
+	return withPosition(b, json.Unmarshal(std, v))
+}
+
+// ValidateJSON returns an error naming the line and column of the first
+// syntax error in the supplied document, which must be strict JSON without
+// comments or trailing commas, if any.
+func ValidateJSON(b []byte) error {
+	var v any
+	return withPosition(b, json.Unmarshal(b, &v))
+}
+
+// withPosition adds the line and column in b to err if it is a JSON syntax
+// error. Offsets into the standardized document are offsets into b.
+func withPosition(b []byte, err error) error {
+	se := &json.SyntaxError{}
+	if errors.As(err, &se) {
+		// The offset is that of the byte after the offending one.
+		line, col := position(b, se.Offset-1)
+		return errors.Errorf(errSyntax, line, col, se.Error())
+	}
+	return err
+}
+
+// standardize returns a copy of b that is plain JSON, with comments and
+// trailing commas replaced by spaces so that offsets into it are also
+// offsets into b.
+func standardize(b []byte) ([]byte, error) {
+	out := bytes.Clone(b)
+	inString := false
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for ; i < len(out) && out[i] != '\n'; i++ {
+				out[i] = ' '
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := bytes.Index(out[i+2:], []byte("*/"))
+			if end < 0 {
+				line, col := position(b, int64(i))
+				return nil, errors.Errorf(errSyntax, line, col, errUnterminatedComment)
+			}
+			for j := i; j < i+2+end+2; j++ {
+				if out[j] != '\n' {
+					out[j] = ' '
+				}
+			}
+			i += 2 + end + 1
+		}
+	}
+
+	inString = false
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == ',':
+			j := i + 1
+			for j < len(out) && bytes.IndexByte([]byte(" \t\r\n"), out[j]) >= 0 {
+				j++
+			}
+			if j < len(out) && (out[j] == '}' || out[j] == ']') {
+				out[i] = ' '
+			}
+		}
+	}
+	return out, nil
+}
+
+// position returns the 1-based line and column of the byte at offset in b.
+func position(b []byte, offset int64) (int, int) {
+	offset = max(0, min(offset, int64(len(b))))
+	before := b[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	return line, len(before) - bytes.LastIndexByte(before, '\n')
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package hujson
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		doc    string
+		want   error
+	}{
+		"JSON": {
+			reason: "Plain JSON is valid HuJSON.",
+			doc:    `{"acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]}`,
+		},
+		"LineComments": {
+			reason: "Line comments should be ignored.",
+			doc: `// Policy
+{
+  "acls": [], // No rules yet.
+}`,
+		},
+		"BlockComments": {
+			reason: "Block comments, including ones spanning lines, should be ignored.",
+			doc: `{
+  /* Groups
+     of users. */
+  "groups": {"group:eng": [/* nobody */]},
+}`,
+		},
+		"CommentMarkersInStrings": {
+			reason: "Comment markers inside strings are part of the string.",
+			doc:    `{"hosts": {"url": "https://example.com/*path*/"}, "note": "// not a comment"}`,
+		},
+		"EscapedQuotes": {
+			reason: "An escaped quote should not end a string, so that a following comment marker stays inside it.",
+			doc:    `{"note": "say \"hi\" // still the string", "other": "\\"}`,
+		},
+		"TrailingCommaObject": {
+			reason: "A trailing comma before } should be accepted.",
+			doc:    `{"a": 1, "b": 2,}`,
+		},
+		"TrailingCommaArray": {
+			reason: "A trailing comma before ], also across lines and comments, should be accepted.",
+			doc: `{"a": [1, 2, // two
+]}`,
+		},
+		"CommaInString": {
+			reason: "A comma followed by a bracket inside a string is not a trailing comma.",
+			doc:    `{"a": ",]"}`,
+		},
+		"UnterminatedBlockComment": {
+			reason: "An unterminated block comment should be reported at the position it starts.",
+			doc: `{
+  "acls": [] /* oops
+}`,
+			want: errors.Errorf(errSyntax, 2, 14, errUnterminatedComment),
+		},
+		"MissingComma": {
+			reason: "A syntax error should be reported at the line and column of the offending character.",
+			doc: `{
+  "acls": [
+    {"action": "accept" "src": []}
+  ]
+}`,
+			want: errors.Errorf(errSyntax, 3, 25, `invalid character '"' after object key:value pair`),
+		},
+		"ErrorAfterComments": {
+			reason: "Positions should count the lines and columns of removed comments.",
+			doc: `/* a
+b */ {"a": // c
+  tru}`,
+			want: errors.Errorf(errSyntax, 3, 6, `invalid character '}' in literal true (expecting 'e')`),
+		},
+		"DoubleComma": {
+			reason: "Only the last of several commas is a trailing comma.",
+			doc:    `{"a": [1,,]}`,
+			want:   errors.Errorf(errSyntax, 1, 11, `invalid character ']' looking for beginning of value`),
+		},
+		"Empty": {
+			reason: "An empty document is not valid.",
+			doc:    ``,
+			want:   errors.Errorf(errSyntax, 1, 1, "unexpected end of JSON input"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate([]byte(tc.doc))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	doc := `{
+  // Owners of every tag.
+  "tagOwners": {
+    "tag:server": ["group:eng"], /* admins */
+  },
+}`
+	type policy struct {
+		TagOwners map[string][]string `json:"tagOwners"`
+	}
+	want := policy{TagOwners: map[string][]string{"tag:server": {"group:eng"}}}
+
+	got := policy{}
+	if err := Unmarshal([]byte(doc), &got); err != nil {
+		t.Fatalf("Unmarshal(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unmarshal(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		doc    string
+		want   error
+	}{
+		"JSON": {
+			reason: "Plain JSON is valid.",
+			doc:    `{"acls": [{"action": "accept", "src": ["*"], "dst": ["*:*"]}]}`,
+		},
+		"CommentMarkersInStrings": {
+			reason: "Comment markers inside strings are part of the string.",
+			doc:    `{"note": "// not a comment"}`,
+		},
+		"Comment": {
+			reason: "Comments should be rejected at their position.",
+			doc: `{
+  // Rules.
+  "acls": []
+}`,
+			want: errors.Errorf(errSyntax, 2, 3, `invalid character '/' looking for beginning of object key string`),
+		},
+		"TrailingComma": {
+			reason: "Trailing commas should be rejected at the bracket following them.",
+			doc:    `{"acls": [],}`,
+			want:   errors.Errorf(errSyntax, 1, 13, `invalid character '}' looking for beginning of object key string`),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateJSON([]byte(tc.doc))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateJSON(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+// Package providerconfig resolves the ProviderConfig a managed resource
+// uses.
+package providerconfig
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DefaultName is the name of the ProviderConfig used by managed resources
+// that do not reference one.
+const DefaultName = "default"
+
+// Name returns the name of the referenced ProviderConfig, or DefaultName if
+// ref is nil or names none.
+func Name(ref *xpv1.Reference) string {
+	if ref == nil || ref.Name == "" {
+		return DefaultName
+	}
+	return ref.Name
+}
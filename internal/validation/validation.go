@@ -0,0 +1,134 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+// Package validation validates the formats of managed resource parameters
+// that the Tailscale API would otherwise only reject when they are applied.
+package validation
+
+import (
+	"fmt"
+	"math"
+	"net/mail"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	tagPrefix = "tag:"
+
+	msgCIDR  = "must be an IPv4 or IPv6 CIDR, such as 10.0.0.0/24"
+	msgTag   = "must be a tag of the form tag:<name>, such as tag:server"
+	msgEmail = "must be an email address, such as admin@example.com"
+
+	msgDNSSuffix = "must be a DNS suffix, such as corp.example.com"
+	msgDeviceID  = "must be the numeric ID or the node ID of a device, such as 123456789 or n1a2b3c4CNTRL, not its name or address"
+
+	msgExpiryFraction = "must be a whole number of seconds, such as 86400 for one day"
+	msgFmtExpiryRange = "must be a number of seconds from 0 to 7776000 (90 days), not %s"
+)
+
+// Patterns of the CRD schemas of the fields CIDRs, Tags and Email check, so
+// that the API server rejects most malformed values even where the webhooks
+// are not installed. PatternCIDR only checks the shape of IPv6 addresses.
+const (
+	PatternCIDR  = `^((25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])(\.(25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])){3}/(3[0-2]|[12]?[0-9])|[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*/(12[0-8]|1[01][0-9]|[1-9]?[0-9]))$`
+	PatternTag   = `^tag:.+$`
+	PatternEmail = `^[^@\s<>]+@[^@\s<>]+$`
+)
+
+// maxExpiry is the longest expiry of keys the Tailscale API accepts.
+const maxExpiry = 90 * 24 * time.Hour
+
+// deviceID matches the legacy numeric ID and the node ID of a device.
+var deviceID = regexp.MustCompile(`^([0-9]+|n[0-9A-Za-z]+CNTRL)$`)
+
+// CIDRs returns an error for each element of routes that is not a CIDR.
+func CIDRs(path *field.Path, routes []*string) field.ErrorList {
+	var errs field.ErrorList
+	for i, r := range routes {
+		if r == nil {
+			continue
+		}
+		if _, err := netip.ParsePrefix(*r); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), *r, msgCIDR))
+		}
+	}
+	return errs
+}
+
+// Tags returns an error for each element of tags that does not have the
+// tag: prefix followed by a name.
+func Tags(path *field.Path, tags []*string) field.ErrorList {
+	var errs field.ErrorList
+	for i, t := range tags {
+		if t == nil {
+			continue
+		}
+		if !strings.HasPrefix(*t, tagPrefix) || len(*t) == len(tagPrefix) {
+			errs = append(errs, field.Invalid(path.Index(i), *t, msgTag))
+		}
+	}
+	return errs
+}
+
+// Email returns an error if email is set but not a bare email address.
+func Email(path *field.Path, email *string) field.ErrorList {
+	if email == nil {
+		return nil
+	}
+	if a, err := mail.ParseAddress(*email); err != nil || a.Address != *email {
+		return field.ErrorList{field.Invalid(path, *email, msgEmail)}
+	}
+	return nil
+}
+
+// DNSSuffixes returns an error for each element of suffixes that is not a
+// DNS domain name. Names are compared case-insensitively and may be fully
+// qualified with a trailing dot.
+func DNSSuffixes(path *field.Path, suffixes []*string) field.ErrorList {
+	var errs field.ErrorList
+	for i, s := range suffixes {
+		if s == nil {
+			continue
+		}
+		if len(validation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(*s, ".")))) > 0 {
+			errs = append(errs, field.Invalid(path.Index(i), *s, msgDNSSuffix))
+		}
+	}
+	return errs
+}
+
+// DeviceID returns an error if id is set but neither the numeric ID nor the
+// node ID of a device, e.g. because a hostname was supplied instead.
+func DeviceID(path *field.Path, id *string) field.ErrorList {
+	if id == nil {
+		return nil
+	}
+	if !deviceID.MatchString(*id) {
+		return field.ErrorList{field.Invalid(path, *id, msgDeviceID)}
+	}
+	return nil
+}
+
+// Expiry returns an error if expiry is set but not a whole number of seconds
+// the Tailscale API accepts as the expiry of a key. Expiries are seconds in
+// the Terraform schema, so out of range values are reported as durations,
+// which makes an expiry given in milliseconds or minutes stand out.
+func Expiry(path *field.Path, expiry *float64) field.ErrorList {
+	if expiry == nil {
+		return nil
+	}
+	if *expiry != math.Trunc(*expiry) {
+		return field.ErrorList{field.Invalid(path, *expiry, msgExpiryFraction)}
+	}
+	if d := time.Duration(*expiry) * time.Second; *expiry < 0 || *expiry > maxExpiry.Seconds() {
+		return field.ErrorList{field.Invalid(path, *expiry, fmt.Sprintf(msgFmtExpiryRange, d))}
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+package validation
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+// matchesPattern checks that pattern matches exactly the values that errs
+// does not report invalid, so that the CRD schemas agree with the webhooks.
+func matchesPattern(t *testing.T, reason, pattern string, path *field.Path, values []*string, errs field.ErrorList) {
+	t.Helper()
+	invalid := map[string]bool{}
+	for _, e := range errs {
+		invalid[e.Field] = true
+	}
+	re := regexp.MustCompile(pattern)
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		if got, want := re.MatchString(*v), !invalid[path.Index(i).String()]; got != want {
+			t.Errorf("\n%s\n%s matches %q: want %t, got %t", reason, pattern, *v, want, got)
+		}
+	}
+}
+
+func TestCIDRs(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "routes")
+
+	cases := map[string]struct {
+		reason string
+		routes []*string
+		want   field.ErrorList
+	}{
+		"Valid": {
+			reason: "IPv4 and IPv6 prefixes, including default routes, are CIDRs.",
+			routes: []*string{ptr.To("10.0.0.0/24"), ptr.To("0.0.0.0/0"), ptr.To("192.168.1.1/32"), ptr.To("fd7a:115c:a1e0::/48"), ptr.To("::/0"), nil},
+		},
+		"Invalid": {
+			reason: "Each element that is not a CIDR should be reported at its index.",
+			routes: []*string{ptr.To("10.0.0.0/24"), ptr.To("10.0.0.0"), ptr.To("10.0.0.0/33"), ptr.To("256.0.0.0/8"), ptr.To("example.com/24"), ptr.To("fd7a::/129")},
+			want: field.ErrorList{
+				field.Invalid(path.Index(1), "10.0.0.0", msgCIDR),
+				field.Invalid(path.Index(2), "10.0.0.0/33", msgCIDR),
+				field.Invalid(path.Index(3), "256.0.0.0/8", msgCIDR),
+				field.Invalid(path.Index(4), "example.com/24", msgCIDR),
+				field.Invalid(path.Index(5), "fd7a::/129", msgCIDR),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CIDRs(path, tc.routes)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nCIDRs(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			matchesPattern(t, tc.reason, PatternCIDR, path, tc.routes, tc.want)
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "tags")
+
+	cases := map[string]struct {
+		reason string
+		tags   []*string
+		want   field.ErrorList
+	}{
+		"Valid": {
+			reason: "Names with the tag: prefix are tags.",
+			tags:   []*string{ptr.To("tag:server"), ptr.To("tag:k8s-operator"), nil},
+		},
+		"Invalid": {
+			reason: "Each element without the tag: prefix or a name should be reported at its index.",
+			tags:   []*string{ptr.To("tag:server"), ptr.To("server"), ptr.To("tag:"), ptr.To("Tag:server"), ptr.To("")},
+			want: field.ErrorList{
+				field.Invalid(path.Index(1), "server", msgTag),
+				field.Invalid(path.Index(2), "tag:", msgTag),
+				field.Invalid(path.Index(3), "Tag:server", msgTag),
+				field.Invalid(path.Index(4), "", msgTag),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Tags(path, tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nTags(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			matchesPattern(t, tc.reason, PatternTag, path, tc.tags, tc.want)
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "account").Index(0).Child("email")
+
+	cases := map[string]struct {
+		reason string
+		email  *string
+		want   field.ErrorList
+	}{
+		"Unset": {
+			reason: "An unset email address is left to the schema to require.",
+		},
+		"Valid": {
+			reason: "A bare email address is valid.",
+			email:  ptr.To("admin@example.com"),
+		},
+		"Subaddress": {
+			reason: "An email address with a subaddress is valid.",
+			email:  ptr.To("admin+tailscale@example.com"),
+		},
+		"NoDomain": {
+			reason: "An address without a domain is not an email address.",
+			email:  ptr.To("admin"),
+			want:   field.ErrorList{field.Invalid(path, "admin", msgEmail)},
+		},
+		"DisplayName": {
+			reason: "An address with a display name is not a bare email address.",
+			email:  ptr.To("Admin <admin@example.com>"),
+			want:   field.ErrorList{field.Invalid(path, "Admin <admin@example.com>", msgEmail)},
+		},
+		"Empty": {
+			reason: "An empty address is not an email address.",
+			email:  ptr.To(""),
+			want:   field.ErrorList{field.Invalid(path, "", msgEmail)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Email(path, tc.email)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nEmail(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if tc.email != nil {
+				want := len(tc.want) == 0
+				if got := regexp.MustCompile(PatternEmail).MatchString(*tc.email); got != want {
+					t.Errorf("\n%s\n%s matches %q: want %t, got %t", tc.reason, PatternEmail, *tc.email, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDNSSuffixes(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "searchPaths")
+
+	cases := map[string]struct {
+		reason   string
+		suffixes []*string
+		want     field.ErrorList
+	}{
+		"Valid": {
+			reason:   "Domain names, including single labels, mixed case and fully qualified names, are DNS suffixes.",
+			suffixes: []*string{ptr.To("example.com"), ptr.To("corp"), ptr.To("Corp.Example.COM"), ptr.To("svc.cluster.local."), nil},
+		},
+		"Invalid": {
+			reason:   "Each element that is not a domain name should be reported at its index.",
+			suffixes: []*string{ptr.To("example.com"), ptr.To(""), ptr.To("https://example.com"), ptr.To("my_domain.com"), ptr.To("-example.com"), ptr.To("example..com")},
+			want: field.ErrorList{
+				field.Invalid(path.Index(1), "", msgDNSSuffix),
+				field.Invalid(path.Index(2), "https://example.com", msgDNSSuffix),
+				field.Invalid(path.Index(3), "my_domain.com", msgDNSSuffix),
+				field.Invalid(path.Index(4), "-example.com", msgDNSSuffix),
+				field.Invalid(path.Index(5), "example..com", msgDNSSuffix),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DNSSuffixes(path, tc.suffixes)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDNSSuffixes(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeviceID(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "deviceId")
+
+	cases := map[string]struct {
+		reason string
+		id     *string
+		want   field.ErrorList
+	}{
+		"Unset": {
+			reason: "An unset device ID is left to the schema to require.",
+		},
+		"Numeric": {
+			reason: "The legacy numeric ID of a device is valid.",
+			id:     ptr.To("12345678901234567"),
+		},
+		"NodeID": {
+			reason: "The node ID of a device is valid.",
+			id:     ptr.To("n1a2b3c4d5CNTRL"),
+		},
+		"Hostname": {
+			reason: "The hostname of a device is not its ID.",
+			id:     ptr.To("web-1.tailnet.ts.net"),
+			want:   field.ErrorList{field.Invalid(path, "web-1.tailnet.ts.net", msgDeviceID)},
+		},
+		"Address": {
+			reason: "The Tailscale address of a device is not its ID.",
+			id:     ptr.To("100.64.0.1"),
+			want:   field.ErrorList{field.Invalid(path, "100.64.0.1", msgDeviceID)},
+		},
+		"TruncatedNodeID": {
+			reason: "A node ID without its CNTRL suffix is malformed.",
+			id:     ptr.To("n1a2b3c4d5"),
+			want:   field.ErrorList{field.Invalid(path, "n1a2b3c4d5", msgDeviceID)},
+		},
+		"Empty": {
+			reason: "An empty device ID is malformed.",
+			id:     ptr.To(""),
+			want:   field.ErrorList{field.Invalid(path, "", msgDeviceID)},
+		},
+		"Whitespace": {
+			reason: "A device ID with surrounding whitespace is malformed.",
+			id:     ptr.To(" 123456789"),
+			want:   field.ErrorList{field.Invalid(path, " 123456789", msgDeviceID)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DeviceID(path, tc.id)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDeviceID(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	path := field.NewPath("spec", "forProvider", "expiry")
+
+	cases := map[string]struct {
+		reason string
+		expiry *float64
+		want   field.ErrorList
+	}{
+		"Unset": {
+			reason: "An unset expiry is left to the Tailscale API's default.",
+		},
+		"Seconds": {
+			reason: "A whole number of seconds within 90 days is an expiry.",
+			expiry: ptr.To[float64](86400),
+		},
+		"Zero": {
+			reason: "A zero expiry is left to the Tailscale API's default.",
+			expiry: ptr.To[float64](0),
+		},
+		"Maximum": {
+			reason: "An expiry of exactly 90 days is accepted.",
+			expiry: ptr.To[float64](7776000),
+		},
+		"Fraction": {
+			reason: "An expiry with a fraction of a second should be rejected.",
+			expiry: ptr.To(1.5),
+			want:   field.ErrorList{field.Invalid(path, 1.5, msgExpiryFraction)},
+		},
+		"Milliseconds": {
+			reason: "An expiry given in milliseconds should be reported as the duration it is in seconds.",
+			expiry: ptr.To[float64](86400000),
+			want:   field.ErrorList{field.Invalid(path, float64(86400000), "must be a number of seconds from 0 to 7776000 (90 days), not 24000h0m0s")},
+		},
+		"Negative": {
+			reason: "A negative expiry should be rejected.",
+			expiry: ptr.To[float64](-3600),
+			want:   field.ErrorList{field.Invalid(path, float64(-3600), "must be a number of seconds from 0 to 7776000 (90 days), not -1h0m0s")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Expiry(path, tc.expiry)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nExpiry(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+/*
+Copyright 2024 Upbound Inc.
+*/
+
+// Package version contains the version of this repo
+package version
+
+// Version will be overridden with the current version at build time using the -X linker flag
+var Version = "0.0.0"